@@ -0,0 +1,3 @@
+data "pingfederate_authentication_selector_descriptor" "example" {
+  id = "com.pingidentity.pf.selectors.saml.SamlAuthnContextAdapterSelector"
+}
@@ -0,0 +1,6 @@
+data "pingfederate_authentication_selector_descriptors" "example" {
+}
+
+output "authentication_selector_class_names" {
+  value = data.pingfederate_authentication_selector_descriptors.example.items[*].class_name
+}
@@ -0,0 +1,3 @@
+data "pingfederate_captcha_provider_descriptor" "example" {
+  id = "com.pingidentity.captcha.recaptchaV3.ReCaptchaV3Plugin"
+}
@@ -0,0 +1,6 @@
+data "pingfederate_captcha_provider_descriptors" "example" {
+}
+
+output "captcha_provider_class_names" {
+  value = data.pingfederate_captcha_provider_descriptors.example.items[*].class_name
+}
@@ -0,0 +1,3 @@
+data "pingfederate_custom_data_store_descriptor" "example" {
+  id = "com.pingidentity.pf.datastore.other.RestDataSourceDriver"
+}
@@ -0,0 +1,6 @@
+data "pingfederate_custom_data_store_descriptors" "example" {
+}
+
+output "custom_data_store_class_names" {
+  value = data.pingfederate_custom_data_store_descriptors.example.items[*].class_name
+}
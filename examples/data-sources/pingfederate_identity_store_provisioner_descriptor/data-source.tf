@@ -0,0 +1,3 @@
+data "pingfederate_identity_store_provisioner_descriptor" "example" {
+  id = "com.pingidentity.identitystoreprovisioners.sample.SampleIdentityStoreProvisioner"
+}
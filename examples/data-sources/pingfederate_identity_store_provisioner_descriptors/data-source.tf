@@ -0,0 +1,6 @@
+data "pingfederate_identity_store_provisioner_descriptors" "example" {
+}
+
+output "identity_store_provisioner_class_names" {
+  value = data.pingfederate_identity_store_provisioner_descriptors.example.items[*].class_name
+}
@@ -0,0 +1,3 @@
+data "pingfederate_idp_adapter_descriptor" "example" {
+  id = "com.pingidentity.adapters.htmlform.idp.HtmlFormIdpAuthnAdapter"
+}
@@ -0,0 +1,6 @@
+data "pingfederate_idp_adapter_descriptors" "example" {
+}
+
+output "idp_adapter_class_names" {
+  value = data.pingfederate_idp_adapter_descriptors.example.items[*].class_name
+}
@@ -0,0 +1,3 @@
+data "pingfederate_idp_token_processor_descriptor" "example" {
+  id = "com.pingidentity.pf.tokenprocessors.username.UsernameTokenProcessor"
+}
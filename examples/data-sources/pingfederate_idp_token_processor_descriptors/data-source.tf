@@ -0,0 +1,6 @@
+data "pingfederate_idp_token_processor_descriptors" "example" {
+}
+
+output "idp_token_processor_class_names" {
+  value = data.pingfederate_idp_token_processor_descriptors.example.items[*].class_name
+}
@@ -0,0 +1,3 @@
+data "pingfederate_notification_publisher_descriptor" "example" {
+  id = "com.pingidentity.email.SmtpNotificationPlugin"
+}
@@ -0,0 +1,6 @@
+data "pingfederate_notification_publisher_descriptors" "example" {
+}
+
+output "notification_publisher_class_names" {
+  value = data.pingfederate_notification_publisher_descriptors.example.items[*].class_name
+}
@@ -0,0 +1,3 @@
+data "pingfederate_oauth_access_token_manager_descriptor" "example" {
+  id = "com.pingidentity.pf.access.token.management.plugins.JwtBearerAccessTokenManagementPlugin"
+}
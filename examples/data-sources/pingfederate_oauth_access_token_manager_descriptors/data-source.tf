@@ -0,0 +1,6 @@
+data "pingfederate_oauth_access_token_manager_descriptors" "example" {
+}
+
+output "oauth_access_token_manager_class_names" {
+  value = data.pingfederate_oauth_access_token_manager_descriptors.example.items[*].class_name
+}
@@ -0,0 +1,3 @@
+data "pingfederate_oauth_out_of_band_auth_plugin_descriptor" "example" {
+  id = "com.pingidentity.oobauth.pingone.mfa.PingOneMfaCibaAuthenticator"
+}
@@ -0,0 +1,6 @@
+data "pingfederate_oauth_out_of_band_auth_plugin_descriptors" "example" {
+}
+
+output "oauth_out_of_band_auth_plugin_class_names" {
+  value = data.pingfederate_oauth_out_of_band_auth_plugin_descriptors.example.items[*].class_name
+}
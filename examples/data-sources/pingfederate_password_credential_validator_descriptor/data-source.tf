@@ -0,0 +1,3 @@
+data "pingfederate_password_credential_validator_descriptor" "example" {
+  id = "org.sourceid.saml20.domain.SimpleUsernamePasswordCredentialValidator"
+}
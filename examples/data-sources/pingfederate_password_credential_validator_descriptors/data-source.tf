@@ -0,0 +1,6 @@
+data "pingfederate_password_credential_validator_descriptors" "example" {
+}
+
+output "password_credential_validator_class_names" {
+  value = data.pingfederate_password_credential_validator_descriptors.example.items[*].class_name
+}
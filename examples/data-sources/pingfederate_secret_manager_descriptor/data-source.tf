@@ -0,0 +1,3 @@
+data "pingfederate_secret_manager_descriptor" "example" {
+  id = "com.pingidentity.pf.secretmanagers.cyberark.CyberArkCredentialProvider"
+}
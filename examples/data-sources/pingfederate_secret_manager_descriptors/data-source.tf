@@ -0,0 +1,6 @@
+data "pingfederate_secret_manager_descriptors" "example" {
+}
+
+output "secret_manager_class_names" {
+  value = data.pingfederate_secret_manager_descriptors.example.items[*].class_name
+}
@@ -0,0 +1,3 @@
+data "pingfederate_sp_adapter_descriptor" "example" {
+  id = "com.pingidentity.adapters.opentoken.SpAuthnAdapter"
+}
@@ -0,0 +1,6 @@
+data "pingfederate_sp_adapter_descriptors" "example" {
+}
+
+output "sp_adapter_class_names" {
+  value = data.pingfederate_sp_adapter_descriptors.example.items[*].class_name
+}
@@ -0,0 +1,3 @@
+data "pingfederate_sp_token_generator_descriptor" "example" {
+  id = "org.sourceid.wstrust.generator.saml.Saml20TokenGenerator"
+}
@@ -0,0 +1,6 @@
+data "pingfederate_sp_token_generator_descriptors" "example" {
+}
+
+output "sp_token_generator_class_names" {
+  value = data.pingfederate_sp_token_generator_descriptors.example.items[*].class_name
+}
@@ -0,0 +1,7 @@
+provider "pingfederate" {
+  client_certificate_pem_file = "/path/to/client.crt"
+  client_private_key_pem_file = "/path/to/client.key"
+  https_host                  = "https://localhost:9999"
+  admin_api_path              = "/pf-admin-api/v1"
+  product_version             = "13.1"
+}
@@ -0,0 +1,11 @@
+provider "pingfederate" {
+  client_id                         = "clientid"
+  private_key_jwt_key_pem_file      = "/path/to/client-signing-key.pem"
+  private_key_jwt_key_id            = "signing-key-1"
+  private_key_jwt_signing_algorithm = "RS256"
+  scopes                            = ["scope"]
+  token_url                         = "https://localhost:9031/as/token.oauth2"
+  https_host                        = "https://localhost:9999"
+  admin_api_path                    = "/pf-admin-api/v1"
+  product_version                   = "13.1"
+}
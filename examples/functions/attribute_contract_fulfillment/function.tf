@@ -0,0 +1,12 @@
+resource "pingfederate_oauth_idp_adapter_mapping" "example" {
+  mapping_id = pingfederate_idp_adapter.example.adapter_id
+
+  attribute_contract_fulfillment = merge(
+    provider::pingfederate::attribute_contract_fulfillment({
+      USER_NAME = "username"
+    }, "ADAPTER", null),
+    provider::pingfederate::attribute_contract_fulfillment({
+      USER_KEY = "entryUUID"
+    }, "LDAP_DATA_STORE", pingfederate_data_store.example.data_store_id),
+  )
+}
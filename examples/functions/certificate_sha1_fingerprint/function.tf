@@ -0,0 +1,3 @@
+output "sha1_fingerprint" {
+  value = provider::pingfederate::certificate_sha1_fingerprint(file("certificate.pem"))
+}
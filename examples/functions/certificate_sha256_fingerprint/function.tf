@@ -0,0 +1,3 @@
+output "sha256_fingerprint" {
+  value = provider::pingfederate::certificate_sha256_fingerprint(file("certificate.pem"))
+}
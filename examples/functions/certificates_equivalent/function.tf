@@ -0,0 +1,3 @@
+output "certificate_is_current" {
+  value = provider::pingfederate::certificates_equivalent(filebase64("certificate.pem"), pingfederate_certificate_ca.example.file_data)
+}
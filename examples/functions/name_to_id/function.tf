@@ -0,0 +1,5 @@
+resource "pingfederate_oauth_access_token_manager" "example" {
+  manager_id = provider::pingfederate::name_to_id(var.token_manager_name)
+  name       = var.token_manager_name
+  # ...
+}
@@ -0,0 +1,3 @@
+output "normalized_certificate" {
+  value = provider::pingfederate::normalize_certificate(filebase64("certificate.pem"))
+}
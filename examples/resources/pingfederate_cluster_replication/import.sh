@@ -0,0 +1 @@
+terraform import pingfederate_cluster_replication.clusterReplication id
\ No newline at end of file
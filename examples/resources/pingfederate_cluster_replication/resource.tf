@@ -0,0 +1,15 @@
+resource "pingfederate_server_settings_general" "generalSettings" {
+  disable_automatic_connection_validation = false
+  request_header_for_correlation_id       = "example"
+}
+
+// Replicate the configuration to all nodes in the cluster whenever the general settings change
+resource "pingfederate_cluster_replication" "clusterReplication" {
+  replication_trigger_values = {
+    "general_settings" : sha256(jsonencode(pingfederate_server_settings_general.generalSettings)),
+  }
+
+  timeouts {
+    create = "10m"
+  }
+}
@@ -63,8 +63,22 @@ func GetTransport() *http.Transport {
 	// Trusting all for the acceptance tests, since they run on localhost
 	// May want to incorporate actual trust here in the future.
 	//#nosec G402
+	tlsConfig := &tls.Config{InsecureSkipVerify: true}
+
+	// Present a client certificate for mutual TLS authentication, if one is configured in the environment
+	clientCertificatePemFile := os.Getenv("PINGFEDERATE_PROVIDER_CLIENT_CERTIFICATE_PEM_FILE")
+	clientPrivateKeyPemFile := os.Getenv("PINGFEDERATE_PROVIDER_CLIENT_PRIVATE_KEY_PEM_FILE")
+	if clientCertificatePemFile != "" && clientPrivateKeyPemFile != "" {
+		clientCertificate, err := tls.LoadX509KeyPair(clientCertificatePemFile, clientPrivateKeyPemFile)
+		if err != nil {
+			fmt.Println("Failed to load client certificate for mutual TLS authentication: " + err.Error())
+		} else {
+			tlsConfig.Certificates = []tls.Certificate{clientCertificate}
+		}
+	}
+
 	return &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		TLSClientConfig: tlsConfig,
 	}
 }
 
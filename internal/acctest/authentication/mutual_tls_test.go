@@ -0,0 +1,61 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package authentication_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest/authentication"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+func TestAccMutualTLSVirtualHostNames(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					authentication.TestEnvVarSlice([]string{"PINGFEDERATE_PROVIDER_CLIENT_CERTIFICATE_PEM_FILE", "PINGFEDERATE_PROVIDER_CLIENT_PRIVATE_KEY_PEM_FILE"}, "mutual_tls_test.go", t)
+				},
+				Config: testAccMutualTLSVirtualHostNames("virtualHostNames"),
+				Check:  testAccMutualTLSGetVirtualHostNames(),
+			},
+		},
+	})
+}
+
+func testAccMutualTLSVirtualHostNames(resourceName string) string {
+	return fmt.Sprintf(`
+resource "pingfederate_virtual_host_names" "%[1]s" {
+  virtual_host_names = ["test"]
+}
+data "pingfederate_virtual_host_names" "%[1]s" {
+  depends_on = [pingfederate_virtual_host_names.%[1]s]
+}`, resourceName,
+	)
+}
+
+// Test that the expected attributes are set on the PingFederate server
+func testAccMutualTLSGetVirtualHostNames() resource.TestCheckFunc {
+	test := func(s *terraform.State) error {
+		// The test client presents the client certificate configured in the environment
+		testClient := acctest.TestClient()
+		_, _, err := testClient.VirtualHostNamesAPI.GetVirtualHostNamesSettings(context.Background()).Execute()
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+	return test
+}
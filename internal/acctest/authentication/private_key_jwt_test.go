@@ -0,0 +1,49 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package authentication_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest/authentication"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+func TestAccPrivateKeyJwtVirtualHostNames(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					authentication.TestEnvVarSlice([]string{"PINGFEDERATE_PROVIDER_OAUTH_CLIENT_ID", "PINGFEDERATE_PROVIDER_OAUTH_PRIVATE_KEY_JWT_KEY_PEM_FILE", "PINGFEDERATE_PROVIDER_OAUTH_TOKEN_URL"}, "private_key_jwt_test.go", t)
+					// The client secret cannot be used in conjunction with private_key_jwt
+					os.Unsetenv("PINGFEDERATE_PROVIDER_OAUTH_CLIENT_SECRET")
+					os.Unsetenv("PINGFEDERATE_PROVIDER_USERNAME")
+					os.Unsetenv("PINGFEDERATE_PROVIDER_PASSWORD")
+				},
+				Config: testAccPrivateKeyJwtVirtualHostNames("virtualHostNames"),
+				Check:  resource.TestCheckResourceAttr(fmt.Sprintf("data.pingfederate_virtual_host_names.%s", "virtualHostNames"), "virtual_host_names.#", "1"),
+			},
+		},
+	})
+}
+
+func testAccPrivateKeyJwtVirtualHostNames(resourceName string) string {
+	return fmt.Sprintf(`
+resource "pingfederate_virtual_host_names" "%[1]s" {
+  virtual_host_names = ["test"]
+}
+data "pingfederate_virtual_host_names" "%[1]s" {
+  depends_on = [pingfederate_virtual_host_names.%[1]s]
+}`, resourceName,
+	)
+}
@@ -0,0 +1,63 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package administrativeaccount_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+func TestAccAdministrativeAccount_PasswordWriteOnly(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		// Write-only attributes require Terraform 1.11 or later
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: administrativeAccount_CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Create the resource with a write-only password, which must not be stored in state
+				Config: administrativeAccount_PasswordWoHCL(1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("pingfederate_administrative_account.example", "password"),
+					resource.TestCheckNoResourceAttr("pingfederate_administrative_account.example", "password_wo"),
+					resource.TestCheckResourceAttr("pingfederate_administrative_account.example", "password_wo_version", "1"),
+					resource.TestCheckResourceAttrSet("pingfederate_administrative_account.example", "encrypted_password"),
+				),
+			},
+			{
+				// Changing the version replaces the account, since the password can't be updated in place
+				Config: administrativeAccount_PasswordWoHCL(2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pingfederate_administrative_account.example", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("pingfederate_administrative_account.example", "password_wo_version", "2"),
+			},
+		},
+	})
+}
+
+func administrativeAccount_PasswordWoHCL(version int) string {
+	return fmt.Sprintf(`
+resource "pingfederate_administrative_account" "example" {
+  username            = "%s"
+  roles               = ["USER_ADMINISTRATOR"]
+  password_wo         = "2FederateM0re!"
+  password_wo_version = %d
+}
+`, administrativeAccountUsername, version)
+}
@@ -0,0 +1,84 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package clusterreplication_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+func TestAccClusterReplication(t *testing.T) {
+	// Check if the server is running in clustered mode or not
+	inCluster := false
+	testClient := acctest.TestClient()
+	_, _, err := testClient.ClusterAPI.GetClusterStatus(acctest.TestBasicAuthContext()).Execute()
+	if err == nil {
+		// The API returned a status, so this server must be in clustered mode
+		inCluster = true
+	}
+	steps := []resource.TestStep{
+		{
+			// Replicate the configuration
+			Config: clusterReplication_HCL("first"),
+			Check:  clusterReplication_CheckComputedValues(inCluster),
+		},
+		{
+			// Changing the trigger value replicates again
+			Config: clusterReplication_HCL("second"),
+			Check:  clusterReplication_CheckComputedValues(inCluster),
+		},
+	}
+	if inCluster {
+		steps = append(steps, resource.TestStep{
+			// Test importing the resource
+			Config:                               clusterReplication_HCL("second"),
+			ResourceName:                         "pingfederate_cluster_replication.example",
+			ImportStateVerifyIdentifierAttribute: "nodes.#",
+			ImportState:                          true,
+			ImportStateVerify:                    true,
+			// The replication trigger values and timeouts are terraform-only, so they can't be imported
+			ImportStateVerifyIgnore: []string{"replication_trigger_values", "timeouts"},
+		})
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: steps,
+	})
+}
+
+func clusterReplication_HCL(triggerValue string) string {
+	return `
+resource "pingfederate_cluster_replication" "example" {
+  replication_trigger_values = {
+    "trigger" : "` + triggerValue + `",
+  }
+
+  timeouts {
+    create = "5m"
+  }
+}
+`
+}
+
+// Validate any computed values when applying HCL
+func clusterReplication_CheckComputedValues(inCluster bool) resource.TestCheckFunc {
+	if inCluster {
+		return resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrSet("pingfederate_cluster_replication.example", "last_replication_time"),
+			resource.TestCheckResourceAttrSet("pingfederate_cluster_replication.example", "nodes.0.address"),
+			resource.TestCheckResourceAttrSet("pingfederate_cluster_replication.example", "nodes.0.replication_status"),
+		)
+	}
+	return resource.ComposeTestCheckFunc(
+		resource.TestCheckNoResourceAttr("pingfederate_cluster_replication.example", "last_replication_time"),
+		resource.TestCheckNoResourceAttr("pingfederate_cluster_replication.example", "nodes"),
+	)
+}
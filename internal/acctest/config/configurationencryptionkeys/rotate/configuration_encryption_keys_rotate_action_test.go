@@ -0,0 +1,73 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package configurationencryptionkeysrotate_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+func TestAccConfigurationEncryptionKeysRotateAction(t *testing.T) {
+	var numStartingKeys int
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.ConfigurationPreCheck(t)
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		// Actions require Terraform 1.14 or later
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				// Rotate the keys when the triggering resource is created
+				PreConfig: func() {
+					numStartingKeys = configurationEncryptionKeysCount(t)
+				},
+				Config: configurationEncryptionKeysRotateActionHCL(),
+				Check: func(_ *terraform.State) error {
+					if numKeys := configurationEncryptionKeysCount(t); numKeys != numStartingKeys+1 {
+						return fmt.Errorf("expected %d configuration encryption keys after rotation, found %d", numStartingKeys+1, numKeys)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+// Get the number of keys currently on the server
+func configurationEncryptionKeysCount(t *testing.T) int {
+	testClient := acctest.TestClient()
+	keys, _, err := testClient.ConfigurationEncryptionKeysAPI.GetConfigurationEncryptionKeys(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		t.Fatal("An error occurred while checking the number of encryption keys on the server: ", err.Error())
+	}
+	return len(keys.Items)
+}
+
+func configurationEncryptionKeysRotateActionHCL() string {
+	return `
+action "pingfederate_configuration_encryption_keys_rotate" "example" {
+}
+
+resource "terraform_data" "example" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.pingfederate_configuration_encryption_keys_rotate.example]
+    }
+  }
+}
+`
+}
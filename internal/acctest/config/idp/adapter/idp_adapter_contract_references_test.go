@@ -0,0 +1,85 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package idpadapter_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+func TestAccIdpAdapter_ContractReferences(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				// ADAPTER source refers to an attribute that is not in the adapter contract
+				Config:      idpAdapter_ContractReferencesHCL("entryUUID"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`attribute 'entryUUID'`),
+			},
+			{
+				// ADAPTER source refers to an attribute in the adapter contract
+				Config:             idpAdapter_ContractReferencesHCL("username"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func idpAdapter_ContractReferencesHCL(adapterAttribute string) string {
+	return fmt.Sprintf(`
+resource "pingfederate_idp_adapter" "example" {
+  adapter_id = "%s"
+  configuration = {
+    tables = [
+      {
+        name = "Credential Validators"
+        rows = [
+          {
+            fields = [
+              {
+                name  = "Password Credential Validator Instance"
+                value = "pingdirectory"
+              }
+            ]
+          }
+        ]
+      }
+    ]
+  }
+  name = "exampleAdapter"
+  plugin_descriptor_ref = {
+    id = "com.pingidentity.adapters.htmlform.idp.HtmlFormIdpAuthnAdapter"
+  }
+  attribute_contract = {
+    core_attributes = [
+      {
+        name      = "username"
+        pseudonym = true
+      }
+    ]
+  }
+  attribute_mapping = {
+    attribute_contract_fulfillment = {
+      "username" = {
+        source = {
+          type = "ADAPTER"
+        }
+        value = "%s"
+      }
+    }
+  }
+}
+`, adapterId, adapterAttribute)
+}
@@ -0,0 +1,94 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package notificationpublishers_test
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+func TestAccNotificationPublisher_DefaultedFields(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		CheckDestroy: notificationPublisher_CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Fields left out of the configuration are reported as defaulted
+				Config: notificationPublisher_MinimalHCL(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingfederate_notification_publisher.example", "configuration.fields.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs("pingfederate_notification_publisher.example", "configuration.fields_defaulted.*",
+						map[string]string{
+							"name":  "SMTP Port",
+							"value": "25",
+						},
+					),
+				),
+			},
+			{
+				// Defaulted fields are left out of fields when importing, so they are not in generated configuration
+				Config:                               notificationPublisher_MinimalHCL(),
+				ResourceName:                         "pingfederate_notification_publisher.example",
+				ImportStateId:                        notificationPublisherPublisherId,
+				ImportStateVerifyIdentifierAttribute: "publisher_id",
+				ImportState:                          true,
+				ImportStateCheck:                     notificationPublisher_CheckImportedFieldsNotDefaulted,
+			},
+		},
+	})
+}
+
+// Check that the imported fields and the defaulted fields don't overlap, and that together they are the fields in
+// fields_all that are not sensitive
+func notificationPublisher_CheckImportedFieldsNotDefaulted(states []*terraform.InstanceState) error {
+	if len(states) != 1 {
+		return fmt.Errorf("expected 1 imported resource, got %d", len(states))
+	}
+	fields := notificationPublisher_FieldValues(states[0].Attributes, "configuration.fields.")
+	defaultedFields := notificationPublisher_FieldValues(states[0].Attributes, "configuration.fields_defaulted.")
+	sensitiveFields := notificationPublisher_FieldValues(states[0].Attributes, "configuration.sensitive_fields.")
+	allFields := notificationPublisher_FieldValues(states[0].Attributes, "configuration.fields_all.")
+
+	if _, ok := defaultedFields["SMTP Port"]; !ok {
+		return fmt.Errorf("expected SMTP Port to be a defaulted field after import")
+	}
+	union := map[string]string{}
+	for name, value := range fields {
+		if _, ok := defaultedFields[name]; ok {
+			return fmt.Errorf("defaulted field %s was included in the imported fields", name)
+		}
+		union[name] = value
+	}
+	maps.Copy(union, defaultedFields)
+	for name := range sensitiveFields {
+		delete(allFields, name)
+	}
+	if !maps.Equal(union, allFields) {
+		return fmt.Errorf("expected fields and fields_defaulted to contain the non-sensitive fields of fields_all %v, got %v", allFields, union)
+	}
+	return nil
+}
+
+// Get the values of the fields in the flattened set attribute with the given prefix, keyed by field name
+func notificationPublisher_FieldValues(attributes map[string]string, prefix string) map[string]string {
+	result := map[string]string{}
+	for key, name := range attributes {
+		if !strings.HasPrefix(key, prefix) || !strings.HasSuffix(key, ".name") {
+			continue
+		}
+		result[name] = attributes[strings.TrimSuffix(key, ".name")+".value"]
+	}
+	return result
+}
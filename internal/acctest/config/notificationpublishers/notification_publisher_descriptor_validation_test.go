@@ -0,0 +1,90 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package notificationpublishers_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+func TestAccNotificationPublisher_DescriptorValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				// Misspelled field name
+				Config: notificationPublisher_DescriptorValidationHCL(`
+      {
+        name  = "Email Server"
+        value = "smtp.example.com"
+      },
+      {
+        name  = "Fromm Address"
+        value = "example@example.com"
+      }`, `
+      {
+        name  = "Password"
+        value = "mypassword"
+      }`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`"Fromm Address" is not a configuration field of plugin`),
+			},
+			{
+				// Sensitive field set in fields, which is only a warning
+				Config: notificationPublisher_DescriptorValidationHCL(`
+      {
+        name  = "Email Server"
+        value = "smtp.example.com"
+      },
+      {
+        name  = "From Address"
+        value = "example@example.com"
+      },
+      {
+        name  = "Password"
+        value = "mypassword"
+      }`, ""),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// Missing required field
+				Config: notificationPublisher_DescriptorValidationHCL(`
+      {
+        name  = "From Address"
+        value = "example@example.com"
+      }`, ""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Field with name Email Server is required"),
+			},
+		},
+	})
+}
+
+func notificationPublisher_DescriptorValidationHCL(fields, sensitiveFields string) string {
+	return fmt.Sprintf(`
+resource "pingfederate_notification_publisher" "example" {
+  publisher_id = "%s"
+  configuration = {
+    fields = [%s
+    ]
+    sensitive_fields = [%s
+    ]
+  }
+  name = "MyNotificationPublisher"
+  plugin_descriptor_ref = {
+    id = "com.pingidentity.email.SmtpNotificationPlugin"
+  }
+}
+`, notificationPublisherPublisherId, fields, sensitiveFields)
+}
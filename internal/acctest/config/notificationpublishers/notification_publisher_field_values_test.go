@@ -0,0 +1,120 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package notificationpublishers_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+func TestAccNotificationPublisher_FieldValues(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		CheckDestroy: notificationPublisher_CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Fields set with maps decoded from JSON
+				Config: notificationPublisher_FieldValuesHCL(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingfederate_notification_publisher.example", "configuration.fields.#", "0"),
+					resource.TestCheckResourceAttr("pingfederate_notification_publisher.example", "configuration.sensitive_fields.#", "0"),
+					resource.TestCheckResourceAttr("pingfederate_notification_publisher.example", "configuration.field_values.%", "3"),
+					resource.TestCheckResourceAttr("pingfederate_notification_publisher.example", "configuration.field_values.Email Server", "smtp.example.com"),
+					resource.TestCheckResourceAttr("pingfederate_notification_publisher.example", "configuration.sensitive_field_values.%", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("pingfederate_notification_publisher.example", "configuration.fields_all.*",
+						map[string]string{
+							"name":  "From Address",
+							"value": "example@example.com",
+						},
+					),
+					resource.TestCheckTypeSetElemNestedAttrs("pingfederate_notification_publisher.example", "configuration.fields_defaulted.*",
+						map[string]string{
+							"name":  "SMTP Port",
+							"value": "25",
+						},
+					),
+				),
+			},
+			{
+				// Move to the nested form with the same values
+				Config: notificationPublisher_MinimalHCL(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingfederate_notification_publisher.example", "configuration.fields.#", "3"),
+					resource.TestCheckNoResourceAttr("pingfederate_notification_publisher.example", "configuration.field_values.%"),
+				),
+			},
+			{
+				// The map and nested forms can't be mixed
+				Config:      notificationPublisher_FieldValuesMixedHCL(),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("cannot be specified when"),
+			},
+		},
+	})
+}
+
+func notificationPublisher_FieldValuesHCL() string {
+	return fmt.Sprintf(`
+locals {
+  publisher_configuration = jsondecode(<<EOT
+{
+  "From Address": "example@example.com",
+  "Email Server": "smtp.example.com",
+  "Username": "example"
+}
+EOT
+  )
+}
+
+resource "pingfederate_notification_publisher" "example" {
+  publisher_id = "%s"
+  configuration = {
+    field_values = local.publisher_configuration
+    sensitive_field_values = {
+      "Password" = "mypassword"
+    }
+  }
+  name = "MyNotificationPublisher"
+  plugin_descriptor_ref = {
+    id = "com.pingidentity.email.SmtpNotificationPlugin"
+  }
+}
+`, notificationPublisherPublisherId)
+}
+
+func notificationPublisher_FieldValuesMixedHCL() string {
+	return fmt.Sprintf(`
+resource "pingfederate_notification_publisher" "example" {
+  publisher_id = "%s"
+  configuration = {
+    fields = [
+      {
+        name  = "Username"
+        value = "example"
+      }
+    ]
+    field_values = {
+      "From Address" = "example@example.com"
+      "Email Server" = "smtp.example.com"
+    }
+    sensitive_field_values = {
+      "Password" = "mypassword"
+    }
+  }
+  name = "MyNotificationPublisher"
+  plugin_descriptor_ref = {
+    id = "com.pingidentity.email.SmtpNotificationPlugin"
+  }
+}
+`, notificationPublisherPublisherId)
+}
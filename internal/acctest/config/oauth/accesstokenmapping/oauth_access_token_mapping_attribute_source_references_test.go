@@ -0,0 +1,105 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package accesstokenmapping_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+func TestAccOauthAccessTokenMapping_AttributeSourceReferences(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				// Fulfillment refers to an attribute source that is not defined
+				Config:      oauthAccessTokenMapping_AttributeSourceReferencesHCL("Directory2", "Directoy", "LDAP_DATA_STORE"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`attribute source 'Directoy'`),
+			},
+			{
+				// Fulfillment source type doesn't match the type of the attribute source
+				Config:      oauthAccessTokenMapping_AttributeSourceReferencesHCL("Directory2", "Directory", "JDBC_DATA_STORE"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`ldap_attribute_source attribute source`),
+			},
+			{
+				// Attribute source ids must be unique
+				Config:      oauthAccessTokenMapping_AttributeSourceReferencesHCL("Directory", "Directory", "LDAP_DATA_STORE"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`id 'Directory' is already`),
+			},
+			{
+				// Fulfillment refers to a defined attribute source with a matching type
+				Config:             oauthAccessTokenMapping_AttributeSourceReferencesHCL("Directory2", "Directory", "LDAP_DATA_STORE"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func oauthAccessTokenMapping_AttributeSourceReferencesHCL(secondAttributeSourceId, fulfillmentSourceId, fulfillmentSourceType string) string {
+	return fmt.Sprintf(`
+resource "pingfederate_oauth_access_token_mapping" "example" {
+  context = {
+    type = "DEFAULT"
+  }
+
+  access_token_manager_ref = {
+    id = "devicesATM"
+  }
+
+  attribute_sources = [
+    {
+      ldap_attribute_source = {
+        base_dn = "ou=Devices,dc=bxretail,dc=org"
+        data_store_ref = {
+          id = "pingdirectory"
+        }
+        description            = "Directory"
+        id                     = "Directory"
+        member_of_nested_group = false
+        search_filter          = "cn=$${USER_KEY}"
+        search_scope           = "SUBTREE"
+        type                   = "LDAP"
+      }
+    },
+    {
+      ldap_attribute_source = {
+        base_dn = "ou=Users,dc=bxretail,dc=org"
+        data_store_ref = {
+          id = "pingdirectory"
+        }
+        description            = "Directory2"
+        id                     = "%s"
+        member_of_nested_group = false
+        search_filter          = "uid=$${USER_KEY}"
+        search_scope           = "SUBTREE"
+        type                   = "LDAP"
+      }
+    },
+  ]
+
+  attribute_contract_fulfillment = {
+    "directory_id" = {
+      source = {
+        id   = "%s"
+        type = "%s"
+      }
+      value = "uid"
+    },
+  }
+}
+`, secondAttributeSourceId, fulfillmentSourceId, fulfillmentSourceType)
+}
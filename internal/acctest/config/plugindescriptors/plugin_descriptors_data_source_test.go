@@ -0,0 +1,60 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package plugindescriptors_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+const htmlFormAdapterClassName = "com.pingidentity.adapters.htmlform.idp.HtmlFormIdpAuthnAdapter"
+
+func TestAccPluginDescriptors(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				// Read a single descriptor and all descriptors of a plugin family
+				Config: pluginDescriptors_HCL(),
+				Check:  pluginDescriptors_CheckComputedValues(),
+			},
+		},
+	})
+}
+
+func pluginDescriptors_HCL() string {
+	return `
+data "pingfederate_idp_adapter_descriptor" "example" {
+  id = "` + htmlFormAdapterClassName + `"
+}
+
+data "pingfederate_notification_publisher_descriptors" "example" {
+}
+`
+}
+
+// Validate any computed values when applying HCL
+func pluginDescriptors_CheckComputedValues() resource.TestCheckFunc {
+	return resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttr("data.pingfederate_idp_adapter_descriptor.example", "class_name", htmlFormAdapterClassName),
+		resource.TestCheckTypeSetElemAttr("data.pingfederate_idp_adapter_descriptor.example", "attribute_contract.*", "username"),
+		resource.TestCheckResourceAttrSet("data.pingfederate_idp_adapter_descriptor.example", "fields.#"),
+		resource.TestCheckTypeSetElemNestedAttrs("data.pingfederate_idp_adapter_descriptor.example", "fields.*", map[string]string{
+			"name":      "Challenge Retries",
+			"sensitive": "false",
+		}),
+		resource.TestCheckResourceAttrSet("data.pingfederate_idp_adapter_descriptor.example", "tables.#"),
+		resource.TestCheckResourceAttrSet("data.pingfederate_notification_publisher_descriptors.example", "items.#"),
+		resource.TestCheckTypeSetElemNestedAttrs("data.pingfederate_notification_publisher_descriptors.example", "items.*", map[string]string{
+			"class_name": "com.pingidentity.email.SmtpNotificationPlugin",
+		}),
+	)
+}
@@ -0,0 +1,128 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package functions_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+const (
+	testCertificate = `-----BEGIN CERTIFICATE-----
+MIIBVDCB+6ADAgECAgEBMAoGCCqGSM49BAMCMDQxMjAwBgNVBAMTKXRlcnJhZm9y
+bS1wcm92aWRlci1waW5nZmVkZXJhdGUtZnVuY3Rpb25zMB4XDTI2MDEwMTAwMDAw
+MFoXDTM2MDEwMTAwMDAwMFowNDEyMDAGA1UEAxMpdGVycmFmb3JtLXByb3ZpZGVy
+LXBpbmdmZWRlcmF0ZS1mdW5jdGlvbnMwWTATBgcqhkjOPQIBBggqhkjOPQMBBwNC
+AAQPPJ8NUEiJ+MkvwLVIp80GmpxTP2XQ2Cj3Obd9J98Upb3gz/AE2Nu9vvSUYp+W
+8rtbUhqsgbt2RDb7lGNcGwmcMAoGCCqGSM49BAMCA0gAMEUCIQD+eVKrbewFDuDq
+WHBzOZ60xDj3i7cRlfp2vYxjmUCDBQIgIXp9WbK1iUFX71MKLTFkLUZj5DWVx3Qg
+YCwRwRD5Yc4=
+-----END CERTIFICATE-----
+`
+	testCertificateSha1Fingerprint   = "1A4824AE103C1469B9ADC1E6EE967D6013DAF711"
+	testCertificateSha256Fingerprint = "7E468F396DD1AAF61A243CB68810243C5ED6CFEE1F87E438435EA3A84AE643DD"
+)
+
+// Functions don't call PingFederate, so these tests don't require a server
+func functionsTestCase(config string, checks ...statecheck.StateCheck) resource.TestCase {
+	return resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		// Provider functions require Terraform 1.8 or later
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:            config,
+				ConfigStateChecks: checks,
+			},
+		},
+	}
+}
+
+func TestAccFunctionNameToId(t *testing.T) {
+	resource.Test(t, functionsTestCase(`
+output "id" {
+  value = provider::pingfederate::name_to_id("My OAuth Client (test)")
+}
+
+output "valid_id" {
+  value = provider::pingfederate::name_to_id("already.valid-id_1")
+}`,
+		statecheck.ExpectKnownOutputValue("id", knownvalue.StringExact("My_OAuth_Client_test")),
+		statecheck.ExpectKnownOutputValue("valid_id", knownvalue.StringExact("already.valid-id_1")),
+	))
+}
+
+func TestAccFunctionCertificates(t *testing.T) {
+	resource.Test(t, functionsTestCase(fmt.Sprintf(`
+locals {
+  certificate = <<EOT
+%s
+EOT
+}
+
+output "normalized" {
+  value = provider::pingfederate::normalize_certificate(base64encode(local.certificate))
+}
+
+output "equivalent" {
+  value = provider::pingfederate::certificates_equivalent(base64encode(local.certificate), replace(local.certificate, "\n", "\r\n"))
+}
+
+output "not_equivalent" {
+  value = provider::pingfederate::certificates_equivalent(local.certificate, "MIIB")
+}
+
+output "sha1_fingerprint" {
+  value = provider::pingfederate::certificate_sha1_fingerprint(local.certificate)
+}
+
+output "sha256_fingerprint" {
+  value = provider::pingfederate::certificate_sha256_fingerprint(base64encode(local.certificate))
+}`, strings.TrimSpace(testCertificate)),
+		statecheck.ExpectKnownOutputValue("normalized", knownvalue.StringExact(testCertificate)),
+		statecheck.ExpectKnownOutputValue("equivalent", knownvalue.Bool(true)),
+		statecheck.ExpectKnownOutputValue("not_equivalent", knownvalue.Bool(false)),
+		statecheck.ExpectKnownOutputValue("sha1_fingerprint", knownvalue.StringExact(testCertificateSha1Fingerprint)),
+		statecheck.ExpectKnownOutputValue("sha256_fingerprint", knownvalue.StringExact(testCertificateSha256Fingerprint)),
+	))
+}
+
+func TestAccFunctionAttributeContractFulfillment(t *testing.T) {
+	resource.Test(t, functionsTestCase(`
+output "fulfillment" {
+  value = merge(
+    provider::pingfederate::attribute_contract_fulfillment({ subject = "username" }, "ADAPTER", null),
+    provider::pingfederate::attribute_contract_fulfillment({ mail = "mail" }, "LDAP_DATA_STORE", "ldapDataStore"),
+  )
+}`,
+		statecheck.ExpectKnownOutputValue("fulfillment", knownvalue.MapExact(map[string]knownvalue.Check{
+			"subject": knownvalue.ObjectExact(map[string]knownvalue.Check{
+				"source": knownvalue.ObjectExact(map[string]knownvalue.Check{
+					"type": knownvalue.StringExact("ADAPTER"),
+					"id":   knownvalue.Null(),
+				}),
+				"value": knownvalue.StringExact("username"),
+			}),
+			"mail": knownvalue.ObjectExact(map[string]knownvalue.Check{
+				"source": knownvalue.ObjectExact(map[string]knownvalue.Check{
+					"type": knownvalue.StringExact("LDAP_DATA_STORE"),
+					"id":   knownvalue.StringExact("ldapDataStore"),
+				}),
+				"value": knownvalue.StringExact("mail"),
+			}),
+		})),
+	))
+}
@@ -0,0 +1,65 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+const adoptExistingClientId = "adoptExistingClient"
+
+func TestAccAdoptExistingOnCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.ConfigurationPreCheck(t)
+			// Create the client outside of Terraform, so that creating the resource conflicts with it
+			existing := client.NewClient(adoptExistingClientId, []string{"CLIENT_CREDENTIALS"}, "Existing client")
+			_, _, err := acctest.TestClient().OauthClientsAPI.CreateOauthClient(acctest.TestBasicAuthContext()).Body(*existing).Execute()
+			if err != nil {
+				t.Fatalf("Failed to create the existing OAuth client: %v", err)
+			}
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdoptExistingOauthClient(),
+				Check:  resource.TestCheckResourceAttr("pingfederate_oauth_client.example", "name", "Adopted client"),
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			_, err := acctest.TestClient().OauthClientsAPI.DeleteOauthClient(acctest.TestBasicAuthContext(), adoptExistingClientId).Execute()
+			if err == nil {
+				return fmt.Errorf("oauth_client still exists after tests. Expected it to be destroyed")
+			}
+			return nil
+		},
+	})
+}
+
+func testAccAdoptExistingOauthClient() string {
+	return fmt.Sprintf(`
+provider "pingfederate" {
+  adopt_existing_on_create = true
+}
+
+resource "pingfederate_oauth_client" "example" {
+  client_id   = "%s"
+  grant_types = ["CLIENT_CREDENTIALS"]
+  name        = "Adopted client"
+  client_auth = {
+    type   = "SECRET"
+    secret = "2FederateM0re!"
+  }
+}`, adoptExistingClientId)
+}
@@ -0,0 +1,71 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+func TestAccCustomHeaders(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomHeaders(),
+				Check:  resource.TestCheckResourceAttrSet("data.pingfederate_virtual_host_names.example", "virtual_host_names.#"),
+			},
+			{
+				Config:      testAccCustomHeadersAuthorization(),
+				ExpectError: regexp.MustCompile("The Authorization header cannot be set in custom_headers"),
+			},
+			{
+				Config:      testAccInvalidHttpProxy(),
+				ExpectError: regexp.MustCompile("Failed to parse proxy URL"),
+			},
+		},
+	})
+}
+
+func testAccCustomHeaders() string {
+	return `
+provider "pingfederate" {
+  custom_headers = {
+    "X-Terraform-Test" = "custom-header-value"
+  }
+}
+
+data "pingfederate_virtual_host_names" "example" {
+}`
+}
+
+func testAccCustomHeadersAuthorization() string {
+	return `
+provider "pingfederate" {
+  custom_headers = {
+    "Authorization" = "Bearer token"
+  }
+}
+
+data "pingfederate_virtual_host_names" "example" {
+}`
+}
+
+func testAccInvalidHttpProxy() string {
+	return `
+provider "pingfederate" {
+  http_proxy = "not a url"
+}
+
+data "pingfederate_virtual_host_names" "example" {
+}`
+}
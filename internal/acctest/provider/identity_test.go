@@ -0,0 +1,70 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package provider_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+func TestAccResourceIdentity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		// Resource identity requires Terraform 1.12 or later
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIdentity(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("pingfederate_oauth_issuer.example", map[string]knownvalue.Check{
+						"issuer_id": knownvalue.StringExact("identityIssuer"),
+					}),
+					// Resources with no identifier attributes are identified by the server
+					statecheck.ExpectIdentity("pingfederate_virtual_host_names.example", map[string]knownvalue.Check{
+						"https_host": knownvalue.StringExact(os.Getenv("PINGFEDERATE_PROVIDER_HTTPS_HOST")),
+					}),
+				},
+			},
+			{
+				// Import using an import block with the identity of the resource
+				Config:          testAccResourceIdentity(),
+				ResourceName:    "pingfederate_oauth_issuer.example",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			{
+				Config:          testAccResourceIdentity(),
+				ResourceName:    "pingfederate_virtual_host_names.example",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func testAccResourceIdentity() string {
+	return `
+resource "pingfederate_oauth_issuer" "example" {
+  issuer_id = "identityIssuer"
+  host      = "identityhost"
+  name      = "identityIssuer"
+}
+
+resource "pingfederate_virtual_host_names" "example" {
+  virtual_host_names = ["identityhost"]
+}`
+}
@@ -0,0 +1,77 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+func TestAccListResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		// List resources require Terraform 1.14 or later
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListResource_OauthClientHCL(),
+			},
+			{
+				// Find the client created in the previous step
+				Query:  true,
+				Config: testAccListResource_OauthClientQueryHCL(),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectIdentity("pingfederate_oauth_client.example", map[string]knownvalue.Check{
+						"client_id": knownvalue.StringExact("listClient"),
+					}),
+					querycheck.ExpectResourceDisplayName("pingfederate_oauth_client.example",
+						queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+							"client_id": knownvalue.StringExact("listClient"),
+						}),
+						knownvalue.StringExact("List Client")),
+					querycheck.ExpectLength("pingfederate_oauth_client.example", 1),
+				},
+			},
+		},
+	})
+}
+
+func testAccListResource_OauthClientHCL() string {
+	return `
+resource "pingfederate_oauth_client" "example" {
+  client_id   = "listClient"
+  name        = "List Client"
+  grant_types = ["CLIENT_CREDENTIALS"]
+  client_auth = {
+    type   = "SECRET"
+    secret = "mysecret"
+  }
+}`
+}
+
+func testAccListResource_OauthClientQueryHCL() string {
+	return `
+provider "pingfederate" {}
+
+list "pingfederate_oauth_client" "example" {
+  provider = pingfederate
+
+  config {
+    filter = "listClient"
+  }
+}`
+}
@@ -0,0 +1,77 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+func TestAccProductVersionAutoDetect(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				// Detect the version when product_version is set to "auto"
+				Config: testAccProductVersionAutoDetect(),
+				Check:  resource.TestCheckResourceAttrSet("data.pingfederate_virtual_host_names.example", "virtual_host_names.#"),
+			},
+			{
+				// Detect the version when product_version is not set at all
+				PreConfig: func() { t.Setenv("PINGFEDERATE_PROVIDER_PRODUCT_VERSION", "") },
+				Config:    testAccProductVersionUnset(),
+				Check:     resource.TestCheckResourceAttrSet("data.pingfederate_virtual_host_names.example", "virtual_host_names.#"),
+			},
+		},
+	})
+}
+
+func TestAccProductVersionNewerThanSupported(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				// Versions newer than any supported version are treated as the latest supported version, with a warning
+				Config: testAccProductVersionNewerThanSupported(),
+				Check:  resource.TestCheckResourceAttrSet("data.pingfederate_virtual_host_names.example", "virtual_host_names.#"),
+			},
+		},
+	})
+}
+
+func testAccProductVersionAutoDetect() string {
+	return `
+provider "pingfederate" {
+  product_version = "auto"
+}
+
+data "pingfederate_virtual_host_names" "example" {
+}`
+}
+
+func testAccProductVersionUnset() string {
+	return `
+data "pingfederate_virtual_host_names" "example" {
+}`
+}
+
+func testAccProductVersionNewerThanSupported() string {
+	return `
+provider "pingfederate" {
+  product_version = "99.0.1"
+}
+
+data "pingfederate_virtual_host_names" "example" {
+}`
+}
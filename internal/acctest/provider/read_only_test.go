@@ -0,0 +1,114 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+func TestAccReadOnly(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				// Data sources still work in read-only mode
+				Config: testAccReadOnlyDataSource(),
+				Check:  resource.TestCheckResourceAttrSet("data.pingfederate_virtual_host_names.example", "virtual_host_names.#"),
+			},
+			{
+				// Planning changes still works in read-only mode
+				Config:             testAccReadOnlyResource(),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:      testAccReadOnlyResource(),
+				ExpectError: regexp.MustCompile("Unable to create pingfederate_virtual_host_names"),
+			},
+			{
+				Config:      testAccReadOnlyRotateResource(),
+				ExpectError: regexp.MustCompile("Unable to create pingfederate_server_settings_system_keys_rotate"),
+			},
+		},
+	})
+}
+
+func TestAccReadOnlyAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		// Actions require Terraform 1.14 or later
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccReadOnlyAction(),
+				ExpectError: regexp.MustCompile("Unable to invoke pingfederate_configuration_encryption_keys_rotate"),
+			},
+		},
+	})
+}
+
+func testAccReadOnlyDataSource() string {
+	return `
+provider "pingfederate" {
+  read_only = true
+}
+
+data "pingfederate_virtual_host_names" "example" {
+}`
+}
+
+func testAccReadOnlyResource() string {
+	return `
+provider "pingfederate" {
+  read_only = true
+}
+
+resource "pingfederate_virtual_host_names" "example" {
+  virtual_host_names = ["example1"]
+}`
+}
+
+func testAccReadOnlyRotateResource() string {
+	return `
+provider "pingfederate" {
+  read_only = true
+}
+
+resource "pingfederate_server_settings_system_keys_rotate" "example" {
+}`
+}
+
+func testAccReadOnlyAction() string {
+	return `
+provider "pingfederate" {
+  read_only = true
+}
+
+action "pingfederate_configuration_encryption_keys_rotate" "example" {
+}
+
+resource "terraform_data" "example" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.pingfederate_configuration_encryption_keys_rotate.example]
+    }
+  }
+}`
+}
@@ -0,0 +1,56 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+func TestAccRestoreOnDestroy(t *testing.T) {
+	var originalLifetime int64
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.ConfigurationPreCheck(t)
+			response, _, err := acctest.TestClient().SessionAPI.GetSessionSettings(acctest.TestBasicAuthContext()).Execute()
+			if err != nil {
+				t.Fatalf("Failed to read the original session settings: %v", err)
+			}
+			originalLifetime = response.GetSessionRevocationLifetime()
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestoreOnDestroySessionSettings(),
+				Check:  resource.TestCheckResourceAttr("pingfederate_session_settings.example", "restore_on_destroy", "true"),
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			response, _, err := acctest.TestClient().SessionAPI.GetSessionSettings(acctest.TestBasicAuthContext()).Execute()
+			if err != nil {
+				return err
+			}
+			if response.GetSessionRevocationLifetime() != originalLifetime {
+				return fmt.Errorf("expected session_revocation_lifetime to be restored to %d, found %d", originalLifetime, response.GetSessionRevocationLifetime())
+			}
+			return nil
+		},
+	})
+}
+
+func testAccRestoreOnDestroySessionSettings() string {
+	return `
+resource "pingfederate_session_settings" "example" {
+  session_revocation_lifetime = 123
+  restore_on_destroy          = true
+}`
+}
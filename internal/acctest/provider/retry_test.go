@@ -0,0 +1,61 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+func TestAccRetryPolicy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRetryPolicy(),
+				Check:  resource.TestCheckResourceAttrSet("data.pingfederate_virtual_host_names.example", "virtual_host_names.#"),
+			},
+			{
+				Config:      testAccRetryPolicyInvalidBackoff(),
+				ExpectError: regexp.MustCompile("min_backoff_seconds cannot be greater than max_backoff_seconds"),
+			},
+		},
+	})
+}
+
+func testAccRetryPolicy() string {
+	return `
+provider "pingfederate" {
+  retry {
+    max_attempts           = 3
+    min_backoff_seconds    = 1
+    max_backoff_seconds    = 5
+    retryable_status_codes = [422, 429, 503]
+  }
+}
+
+data "pingfederate_virtual_host_names" "example" {
+}`
+}
+
+func testAccRetryPolicyInvalidBackoff() string {
+	return `
+provider "pingfederate" {
+  retry {
+    min_backoff_seconds = 10
+    max_backoff_seconds = 5
+  }
+}
+
+data "pingfederate_virtual_host_names" "example" {
+}`
+}
@@ -0,0 +1,71 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+const serializedWritesKeyIdPrefix = "serializedwriteskey"
+const serializedWritesKeyCount = 5
+
+// Generate several signing key pairs in parallel. Key pair generation requests are serialized by the provider.
+func TestAccSerializedWrites(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		CheckDestroy: serializedWrites_CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSerializedWrites(),
+				Check:  serializedWrites_CheckKeys(),
+			},
+		},
+	})
+}
+
+func testAccSerializedWrites() string {
+	return fmt.Sprintf(`
+resource "pingfederate_keypairs_signing_key" "example" {
+  count         = %d
+  key_id        = "%s${count.index}"
+  common_name   = "Example ${count.index}"
+  country       = "US"
+  key_algorithm = "RSA"
+  organization  = "Ping Identity"
+  valid_days    = 365
+}`, serializedWritesKeyCount, serializedWritesKeyIdPrefix)
+}
+
+func serializedWrites_CheckKeys() resource.TestCheckFunc {
+	testChecks := []resource.TestCheckFunc{}
+	for i := range serializedWritesKeyCount {
+		testChecks = append(testChecks,
+			resource.TestCheckResourceAttr(fmt.Sprintf("pingfederate_keypairs_signing_key.example.%d", i), "id", fmt.Sprintf("%s%d", serializedWritesKeyIdPrefix, i)),
+			resource.TestCheckResourceAttr(fmt.Sprintf("pingfederate_keypairs_signing_key.example.%d", i), "status", "VALID"),
+		)
+	}
+	return resource.ComposeTestCheckFunc(testChecks...)
+}
+
+func serializedWrites_CheckDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	for i := range serializedWritesKeyCount {
+		keyId := fmt.Sprintf("%s%d", serializedWritesKeyIdPrefix, i)
+		_, err := testClient.KeyPairsSigningAPI.DeleteSigningKeyPair(acctest.TestBasicAuthContext(), keyId).Execute()
+		if err == nil {
+			return fmt.Errorf("keypairs_signing_key %s still exists after tests. Expected it to be destroyed", keyId)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+func TestAccTimeouts(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTimeouts("5m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingfederate_virtual_host_names.example", "timeouts.update", "5m"),
+					resource.TestCheckResourceAttr("pingfederate_virtual_host_names.example", "virtual_host_names.#", "1"),
+				),
+			},
+			{
+				Config: testAccTimeouts("10m"),
+				Check:  resource.TestCheckResourceAttr("pingfederate_virtual_host_names.example", "timeouts.update", "10m"),
+			},
+			{
+				// The timeouts block is not returned by PingFederate, so it isn't set on import
+				Config:                               testAccTimeouts("10m"),
+				ResourceName:                         "pingfederate_virtual_host_names.example",
+				ImportStateVerifyIdentifierAttribute: "virtual_host_names.#",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIgnore:              []string{"timeouts"},
+			},
+			{
+				Config:      testAccTimeouts("ten minutes"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+		},
+	})
+}
+
+func testAccTimeouts(updateTimeout string) string {
+	return `
+provider "pingfederate" {
+  request_timeout_seconds = 60
+}
+
+resource "pingfederate_virtual_host_names" "example" {
+  virtual_host_names = ["example1"]
+
+  timeouts {
+    update = "` + updateTimeout + `"
+  }
+}`
+}
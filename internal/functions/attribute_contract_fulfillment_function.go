@@ -0,0 +1,97 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package functions
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/attributecontractfulfillment"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/sourcetypeidkey"
+)
+
+var _ function.Function = &attributeContractFulfillmentFunction{}
+
+// AttributeContractFulfillmentFunction is a helper function to simplify the provider implementation.
+func AttributeContractFulfillmentFunction() function.Function {
+	return &attributeContractFulfillmentFunction{}
+}
+
+type attributeContractFulfillmentFunction struct{}
+
+func (f *attributeContractFulfillmentFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "attribute_contract_fulfillment"
+}
+
+func (f *attributeContractFulfillmentFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build an attribute_contract_fulfillment map from a simple map",
+		Description: "Builds a value for an `attribute_contract_fulfillment` attribute, fulfilling each attribute in a map from the same source. " +
+			"For example, `provider::pingfederate::attribute_contract_fulfillment({ subject = \"username\" }, \"ADAPTER\", null)` is equivalent to `{ subject = { source = { type = \"ADAPTER\", id = null }, value = \"username\" } }`. " +
+			"Use `merge` to combine attributes fulfilled from different sources.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:        "values",
+				ElementType: types.StringType,
+				Description: "Map of attribute contract attribute names to the value that fulfills each attribute, such as the name of an attribute from the source or an OGNL expression.",
+			},
+			function.StringParameter{
+				Name:        "source_type",
+				Description: fmt.Sprintf("The source type of the values. Options are `%s`.", strings.Join(sourcetypeidkey.SourceTypes, "`, `")),
+			},
+			function.StringParameter{
+				Name:           "source_id",
+				AllowNullValue: true,
+				Description:    "The ID of the attribute source of the values, or null if the source type is not an attribute source.",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: attributecontractfulfillment.ObjType(),
+		},
+	}
+}
+
+func (f *attributeContractFulfillmentFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var values map[string]string
+	var sourceType string
+	var sourceId types.String
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &values, &sourceType, &sourceId))
+	if resp.Error != nil {
+		return
+	}
+
+	if !slices.Contains(sourcetypeidkey.SourceTypes, sourceType) {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid source type %s. Options are %s", sourceType, strings.Join(sourcetypeidkey.SourceTypes, ", ")))
+		return
+	}
+
+	source, diags := types.ObjectValue(sourcetypeidkey.AttrTypes(), map[string]attr.Value{
+		"type": types.StringValue(sourceType),
+		"id":   sourceId,
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	fulfillment := make(map[string]attr.Value, len(values))
+	for name, value := range values {
+		fulfillmentValue, diags := types.ObjectValue(attributecontractfulfillment.AttrTypes(), map[string]attr.Value{
+			"source": source,
+			"value":  types.StringValue(value),
+		})
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+		fulfillment[name] = fulfillmentValue
+	}
+	if resp.Error != nil {
+		return
+	}
+
+	result, diags := types.MapValue(attributecontractfulfillment.ObjType(), fulfillment)
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,85 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package functions
+
+import (
+	"context"
+	"crypto/sha1" // #nosec G505 -- SHA-1 is only used to compute the fingerprint reported by PingFederate
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"hash"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/pemcertificates"
+)
+
+var _ function.Function = &certificateFingerprintFunction{}
+
+// CertificateSha1FingerprintFunction is a helper function to simplify the provider implementation.
+func CertificateSha1FingerprintFunction() function.Function {
+	return &certificateFingerprintFunction{
+		name:      "certificate_sha1_fingerprint",
+		algorithm: "SHA-1",
+		newHash:   sha1.New,
+	}
+}
+
+// CertificateSha256FingerprintFunction is a helper function to simplify the provider implementation.
+func CertificateSha256FingerprintFunction() function.Function {
+	return &certificateFingerprintFunction{
+		name:      "certificate_sha256_fingerprint",
+		algorithm: "SHA-256",
+		newHash:   sha256.New,
+	}
+}
+
+type certificateFingerprintFunction struct {
+	name      string
+	algorithm string
+	newHash   func() hash.Hash
+}
+
+func (f *certificateFingerprintFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.name
+}
+
+func (f *certificateFingerprintFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Compute the " + f.algorithm + " fingerprint of a certificate",
+		Description: "Computes the " + f.algorithm + " fingerprint of a certificate, as uppercase hexadecimal without separators, which is the format PingFederate uses for certificate fingerprints. " +
+			"The certificate can be PEM encoded, base64-encoded PEM, or base64-encoded DER without a header and footer.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "certificate",
+				Description: "The certificate to compute the fingerprint of.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *certificateFingerprintFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var certificate string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &certificate))
+	if resp.Error != nil {
+		return
+	}
+
+	der, err := base64.StdEncoding.DecodeString(pemcertificates.StrippedFileData(certificate))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Failed to decode the certificate data: "+err.Error())
+		return
+	}
+	if _, err := x509.ParseCertificate(der); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Failed to parse the certificate: "+err.Error())
+		return
+	}
+
+	h := f.newHash()
+	h.Write(der)
+	fingerprint := strings.ToUpper(hex.EncodeToString(h.Sum(nil)))
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, fingerprint))
+}
@@ -0,0 +1,55 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/pemcertificates"
+)
+
+var _ function.Function = &certificatesEquivalentFunction{}
+
+// CertificatesEquivalentFunction is a helper function to simplify the provider implementation.
+func CertificatesEquivalentFunction() function.Function {
+	return &certificatesEquivalentFunction{}
+}
+
+type certificatesEquivalentFunction struct{}
+
+func (f *certificatesEquivalentFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "certificates_equivalent"
+}
+
+func (f *certificatesEquivalentFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Compare PEM or base64-encoded certificate data",
+		Description: "Returns true if two certificates are the same, ignoring differences in formatting. Each certificate can be PEM encoded or base64-encoded PEM, in the same way as the `file_data` of certificate resources.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "certificate",
+				Description: "The first certificate to compare.",
+			},
+			function.StringParameter{
+				Name:        "other_certificate",
+				Description: "The second certificate to compare.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *certificatesEquivalentFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var certificate, otherCertificate string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &certificate, &otherCertificate))
+	if resp.Error != nil {
+		return
+	}
+
+	// FileDataEquivalent only decodes its first argument, so compare in both directions
+	equivalent := pemcertificates.FileDataEquivalent(certificate, otherCertificate) ||
+		pemcertificates.FileDataEquivalent(otherCertificate, certificate) ||
+		pemcertificates.StrippedFileData(certificate) == pemcertificates.StrippedFileData(otherCertificate)
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, equivalent))
+}
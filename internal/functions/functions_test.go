@@ -0,0 +1,181 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package functions_test
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/functions"
+)
+
+const (
+	testCertificate = `-----BEGIN CERTIFICATE-----
+MIIBVDCB+6ADAgECAgEBMAoGCCqGSM49BAMCMDQxMjAwBgNVBAMTKXRlcnJhZm9y
+bS1wcm92aWRlci1waW5nZmVkZXJhdGUtZnVuY3Rpb25zMB4XDTI2MDEwMTAwMDAw
+MFoXDTM2MDEwMTAwMDAwMFowNDEyMDAGA1UEAxMpdGVycmFmb3JtLXByb3ZpZGVy
+LXBpbmdmZWRlcmF0ZS1mdW5jdGlvbnMwWTATBgcqhkjOPQIBBggqhkjOPQMBBwNC
+AAQPPJ8NUEiJ+MkvwLVIp80GmpxTP2XQ2Cj3Obd9J98Upb3gz/AE2Nu9vvSUYp+W
+8rtbUhqsgbt2RDb7lGNcGwmcMAoGCCqGSM49BAMCA0gAMEUCIQD+eVKrbewFDuDq
+WHBzOZ60xDj3i7cRlfp2vYxjmUCDBQIgIXp9WbK1iUFX71MKLTFkLUZj5DWVx3Qg
+YCwRwRD5Yc4=
+-----END CERTIFICATE-----
+`
+	testCertificateSha1Fingerprint   = "1A4824AE103C1469B9ADC1E6EE967D6013DAF711"
+	testCertificateSha256Fingerprint = "7E468F396DD1AAF61A243CB68810243C5ED6CFEE1F87E438435EA3A84AE643DD"
+)
+
+// Call the Run method of a function with the given arguments
+func run(t *testing.T, f function.Function, args ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+	ctx := context.Background()
+	var definitionResp function.DefinitionResponse
+	f.Definition(ctx, function.DefinitionRequest{}, &definitionResp)
+	result, funcErr := definitionResp.Definition.Return.NewResultData(ctx)
+	if funcErr != nil {
+		t.Fatalf("Failed to create result data: %s", funcErr.Text)
+	}
+	resp := function.RunResponse{Result: result}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(args)}, &resp)
+	return resp.Result.Value(), resp.Error
+}
+
+func TestNameToId(t *testing.T) {
+	testCases := []struct {
+		name        string
+		expected    string
+		expectError bool
+	}{
+		{name: "My OAuth Client (test)", expected: "My_OAuth_Client_test"},
+		{name: "already.valid-id_1", expected: "already.valid-id_1"},
+		{name: "  spaces at the edges  ", expected: "spaces_at_the_edges"},
+		{name: "_underscores_at_the_edges_", expected: "_underscores_at_the_edges_"},
+		{name: "___", expected: "___"},
+		{name: "Café & Bar", expected: "Caf_Bar"},
+		{name: "", expectError: true},
+		{name: " () ", expectError: true},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, funcErr := run(t, functions.NameToIdFunction(), types.StringValue(testCase.name))
+			if testCase.expectError {
+				if funcErr == nil {
+					t.Errorf("expected an error, got %s", result)
+				}
+				return
+			}
+			if funcErr != nil {
+				t.Fatalf("unexpected error: %s", funcErr.Text)
+			}
+			if !result.Equal(types.StringValue(testCase.expected)) {
+				t.Errorf("expected %q, got %s", testCase.expected, result)
+			}
+		})
+	}
+}
+
+func TestNormalizeCertificate(t *testing.T) {
+	for name, input := range map[string]string{
+		"PEM":              testCertificate,
+		"base64 PEM":       base64.StdEncoding.EncodeToString([]byte(testCertificate)),
+		"CRLF line breaks": strings.ReplaceAll(testCertificate, "\n", "\r\n"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			result, funcErr := run(t, functions.NormalizeCertificateFunction(), types.StringValue(input))
+			if funcErr != nil {
+				t.Fatalf("unexpected error: %s", funcErr.Text)
+			}
+			if !result.Equal(types.StringValue(testCertificate)) {
+				t.Errorf("expected %q, got %s", testCertificate, result)
+			}
+		})
+	}
+}
+
+func TestCertificatesEquivalent(t *testing.T) {
+	testCases := []struct {
+		name     string
+		other    string
+		expected bool
+	}{
+		{name: "base64 and CRLF", other: strings.ReplaceAll(testCertificate, "\n", "\r\n"), expected: true},
+		{name: "different certificate", other: "MIIB", expected: false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, funcErr := run(t, functions.CertificatesEquivalentFunction(),
+				types.StringValue(base64.StdEncoding.EncodeToString([]byte(testCertificate))), types.StringValue(testCase.other))
+			if funcErr != nil {
+				t.Fatalf("unexpected error: %s", funcErr.Text)
+			}
+			if !result.Equal(types.BoolValue(testCase.expected)) {
+				t.Errorf("expected %t, got %s", testCase.expected, result)
+			}
+		})
+	}
+}
+
+func TestCertificateFingerprints(t *testing.T) {
+	testCases := []struct {
+		name        string
+		function    function.Function
+		certificate string
+		expected    string
+		expectError bool
+	}{
+		{name: "SHA-1 of PEM", function: functions.CertificateSha1FingerprintFunction(), certificate: testCertificate, expected: testCertificateSha1Fingerprint},
+		{name: "SHA-256 of base64 PEM", function: functions.CertificateSha256FingerprintFunction(), certificate: base64.StdEncoding.EncodeToString([]byte(testCertificate)), expected: testCertificateSha256Fingerprint},
+		{name: "invalid certificate", function: functions.CertificateSha256FingerprintFunction(), certificate: "not a certificate", expectError: true},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, funcErr := run(t, testCase.function, types.StringValue(testCase.certificate))
+			if testCase.expectError {
+				if funcErr == nil {
+					t.Errorf("expected an error, got %s", result)
+				}
+				return
+			}
+			if funcErr != nil {
+				t.Fatalf("unexpected error: %s", funcErr.Text)
+			}
+			if !result.Equal(types.StringValue(testCase.expected)) {
+				t.Errorf("expected %q, got %s", testCase.expected, result)
+			}
+		})
+	}
+}
+
+func TestAttributeContractFulfillment(t *testing.T) {
+	values := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"mail": types.StringValue("mail"),
+	})
+
+	result, funcErr := run(t, functions.AttributeContractFulfillmentFunction(), values, types.StringValue("LDAP_DATA_STORE"), types.StringValue("ldapDataStore"))
+	if funcErr != nil {
+		t.Fatalf("unexpected error: %s", funcErr.Text)
+	}
+	expected := `{"mail":{"source":{"id":"ldapDataStore","type":"LDAP_DATA_STORE"},"value":"mail"}}`
+	if result.String() != expected {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+
+	result, funcErr = run(t, functions.AttributeContractFulfillmentFunction(), values, types.StringValue("ADAPTER"), types.StringNull())
+	if funcErr != nil {
+		t.Fatalf("unexpected error: %s", funcErr.Text)
+	}
+	expected = `{"mail":{"source":{"id":<null>,"type":"ADAPTER"},"value":"mail"}}`
+	if result.String() != expected {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+
+	_, funcErr = run(t, functions.AttributeContractFulfillmentFunction(), values, types.StringValue("NOT_A_SOURCE"), types.StringNull())
+	if funcErr == nil {
+		t.Errorf("expected an error for an invalid source type")
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package functions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/configvalidators"
+)
+
+var _ function.Function = &nameToIdFunction{}
+
+// NameToIdFunction is a helper function to simplify the provider implementation.
+func NameToIdFunction() function.Function {
+	return &nameToIdFunction{}
+}
+
+type nameToIdFunction struct{}
+
+func (f *nameToIdFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "name_to_id"
+}
+
+func (f *nameToIdFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert a display name into a valid PingFederate ID",
+		Description: "Converts a display name into an ID that contains only the characters `[a-zA-Z0-9._-]`, which is valid for the `id` of most PingFederate objects. Each run of other characters, such as spaces, is replaced with a single underscore, except at the start and end of the name, where it is removed. For example, `My OAuth Client (test)` is converted to `My_OAuth_Client_test`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "The display name to convert.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *nameToIdFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	id := nameToId(name)
+	if id == "" {
+		resp.Error = function.NewArgumentFuncError(0, "The name must contain at least one letter, number, period, hyphen, or underscore")
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, id))
+}
+
+// Replace each run of invalid characters with an underscore, and remove the runs at the start and end of the name.
+// Underscores in the name itself are valid, and are kept.
+func nameToId(name string) string {
+	var id strings.Builder
+	previousEnd := 0
+	for _, match := range configvalidators.InvalidPingFederateIdCharacters.FindAllStringIndex(name, -1) {
+		id.WriteString(name[previousEnd:match[0]])
+		if match[0] > 0 && match[1] < len(name) {
+			id.WriteString("_")
+		}
+		previousEnd = match[1]
+	}
+	id.WriteString(name[previousEnd:])
+	return id.String()
+}
@@ -0,0 +1,47 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/pemcertificates"
+)
+
+var _ function.Function = &normalizeCertificateFunction{}
+
+// NormalizeCertificateFunction is a helper function to simplify the provider implementation.
+func NormalizeCertificateFunction() function.Function {
+	return &normalizeCertificateFunction{}
+}
+
+type normalizeCertificateFunction struct{}
+
+func (f *normalizeCertificateFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_certificate"
+}
+
+func (f *normalizeCertificateFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Normalize PEM or base64-encoded certificate data",
+		Description: "Formats a certificate as PEM, with a header and footer and the certificate data wrapped at 64 characters. The certificate can be PEM encoded, base64-encoded PEM, or base64-encoded DER without a header and footer, and can use any line endings. Certificates that PingFederate considers the same, such as the `file_data` in configuration and the value returned by PingFederate, are normalized to the same value.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "certificate",
+				Description: "The certificate data to normalize.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *normalizeCertificateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var certificate string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &certificate))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, pemcertificates.NormalizedFileData(certificate)))
+}
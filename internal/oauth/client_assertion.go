@@ -0,0 +1,185 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package oauth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Client assertion type for private_key_jwt client authentication, defined by RFC 7523
+	ClientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+	DefaultSigningAlgorithm = "RS256"
+
+	// Lifetime of each signed client assertion
+	clientAssertionLifetime = 5 * time.Minute
+)
+
+var SupportedSigningAlgorithms = []string{
+	"RS256", "RS384", "RS512",
+	"PS256", "PS384", "PS512",
+	"ES256", "ES384", "ES512",
+}
+
+// Signs JWT client assertions for private_key_jwt client authentication
+type ClientAssertionSigner struct {
+	key        crypto.Signer
+	keyId      string
+	algorithm  string
+	hash       crypto.Hash
+	clientId   string
+	audience   string
+	curveBytes int
+}
+
+// NewClientAssertionSigner loads a PEM-encoded RSA or EC private key from a file, and verifies that it can be used
+// with the given signing algorithm.
+func NewClientAssertionSigner(keyPemFile, keyId, algorithm, clientId, tokenUrl string) (*ClientAssertionSigner, error) {
+	if algorithm == "" {
+		algorithm = DefaultSigningAlgorithm
+	}
+	if !slices.Contains(SupportedSigningAlgorithms, algorithm) {
+		return nil, fmt.Errorf("unsupported signing algorithm '%s'. Supported algorithms are: %s", algorithm, strings.Join(SupportedSigningAlgorithms, ", "))
+	}
+
+	keyPem, err := os.ReadFile(filepath.Clean(keyPemFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read private key file %s: %w", keyPemFile, err)
+	}
+	key, err := parsePrivateKey(keyPem)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key from file %s: %w", keyPemFile, err)
+	}
+
+	signer := &ClientAssertionSigner{
+		key:       key,
+		keyId:     keyId,
+		algorithm: algorithm,
+		clientId:  clientId,
+		audience:  tokenUrl,
+	}
+	switch algorithm[2:] {
+	case "256":
+		signer.hash = crypto.SHA256
+	case "384":
+		signer.hash = crypto.SHA384
+	case "512":
+		signer.hash = crypto.SHA512
+	}
+
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		if strings.HasPrefix(algorithm, "ES") {
+			return nil, fmt.Errorf("signing algorithm %s requires an EC private key, but an RSA private key was provided", algorithm)
+		}
+	case *ecdsa.PrivateKey:
+		if !strings.HasPrefix(algorithm, "ES") {
+			return nil, fmt.Errorf("signing algorithm %s requires an RSA private key, but an EC private key was provided", algorithm)
+		}
+		signer.curveBytes = (k.Curve.Params().BitSize + 7) / 8
+	default:
+		return nil, errors.New("only RSA and EC private keys are supported")
+	}
+
+	return signer, nil
+}
+
+func parsePrivateKey(keyPem []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(keyPem)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, errors.New("unsupported private key type")
+		}
+		return signer, nil
+	}
+	return nil, fmt.Errorf("unsupported PEM block type '%s'", block.Type)
+}
+
+// Sign a new client assertion. A new assertion with a unique ID is needed for each token request.
+func (s *ClientAssertionSigner) Sign() (string, error) {
+	header := map[string]string{
+		"alg": s.algorithm,
+		"typ": "JWT",
+	}
+	if s.keyId != "" {
+		header["kid"] = s.keyId
+	}
+	now := time.Now()
+	claims := map[string]any{
+		"iss": s.clientId,
+		"sub": s.clientId,
+		"aud": s.audience,
+		"jti": uuid.NewString(),
+		"iat": now.Unix(),
+		"exp": now.Add(clientAssertionLifetime).Unix(),
+	}
+
+	headerJson, err := json.Marshal(header)
+	if err != nil {
+		return "", err
+	}
+	claimsJson, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	signingInput := base64.RawURLEncoding.EncodeToString(headerJson) + "." + base64.RawURLEncoding.EncodeToString(claimsJson)
+
+	hasher := s.hash.New()
+	hasher.Write([]byte(signingInput))
+	digest := hasher.Sum(nil)
+
+	var signature []byte
+	switch key := s.key.(type) {
+	case *rsa.PrivateKey:
+		if strings.HasPrefix(s.algorithm, "PS") {
+			signature, err = rsa.SignPSS(rand.Reader, key, s.hash, digest, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		} else {
+			signature, err = rsa.SignPKCS1v15(rand.Reader, key, s.hash, digest)
+		}
+	case *ecdsa.PrivateKey:
+		// JWS uses the fixed-length concatenation of r and s rather than ASN.1
+		var r, sValue *big.Int
+		r, sValue, err = ecdsa.Sign(rand.Reader, key, digest)
+		if err == nil {
+			signature = make([]byte, 2*s.curveBytes)
+			r.FillBytes(signature[:s.curveBytes])
+			sValue.FillBytes(signature[s.curveBytes:])
+		}
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to sign client assertion: %w", err)
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
@@ -0,0 +1,79 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package oauth
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// Cached tokens are refreshed when they are within this duration of expiring, so that a token
+// does not expire while a request is in flight
+const TokenRefreshBuffer = time.Minute
+
+// Configuration for requesting access tokens with the client credentials grant
+type TokenSourceConfig struct {
+	HttpClient   *http.Client
+	TokenUrl     string
+	ClientId     string
+	ClientSecret string
+	Scopes       []string
+	// If set, the client authenticates with a signed JWT client assertion (private_key_jwt) rather than a client secret
+	ClientAssertionSigner *ClientAssertionSigner
+}
+
+type clientCredentialsTokenSource struct {
+	// Context used for logging token requests
+	logCtx context.Context
+	config TokenSourceConfig
+}
+
+// NewCachingTokenSource returns a token source that caches the access token and only requests a new one
+// when the cached token is about to expire. A single token source is shared by all resources and data sources.
+func NewCachingTokenSource(ctx context.Context, config TokenSourceConfig) oauth2.TokenSource {
+	return oauth2.ReuseTokenSourceWithExpiry(nil, &clientCredentialsTokenSource{
+		logCtx: ctx,
+		config: config,
+	}, TokenRefreshBuffer)
+}
+
+func (ts *clientCredentialsTokenSource) Token() (*oauth2.Token, error) {
+	clientCredentialsConfig := &clientcredentials.Config{
+		TokenURL:     ts.config.TokenUrl,
+		ClientID:     ts.config.ClientId,
+		ClientSecret: ts.config.ClientSecret,
+		Scopes:       ts.config.Scopes,
+	}
+	if ts.config.ClientAssertionSigner != nil {
+		clientAssertion, err := ts.config.ClientAssertionSigner.Sign()
+		if err != nil {
+			return nil, err
+		}
+		clientCredentialsConfig.AuthStyle = oauth2.AuthStyleInParams
+		clientCredentialsConfig.EndpointParams = url.Values{
+			"client_assertion_type": {ClientAssertionType},
+			"client_assertion":      {clientAssertion},
+		}
+	}
+
+	tflog.Debug(ts.logCtx, "Requesting new OAuth access token from "+ts.config.TokenUrl)
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, ts.config.HttpClient)
+	token, err := clientCredentialsConfig.Token(ctx)
+	if err != nil {
+		tflog.Warn(ts.logCtx, "Failed to retrieve OAuth access token: "+err.Error())
+		return nil, err
+	}
+
+	if token.Expiry.IsZero() {
+		tflog.Debug(ts.logCtx, "Retrieved OAuth access token with no expiry, it will be cached for the lifetime of the provider")
+	} else {
+		tflog.Debug(ts.logCtx, "Retrieved OAuth access token, it will be cached until "+token.Expiry.Add(-TokenRefreshBuffer).Format(time.RFC3339))
+	}
+	return token, nil
+}
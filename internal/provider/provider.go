@@ -8,24 +8,38 @@ import (
 	"crypto/x509"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest/common/pointers"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/functions"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/oauth"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/api"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/plugindescriptor"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/policycontract"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/administrativeaccount"
 	authenticationapiapplication "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/authenticationapi/application"
 	authenticationapisettings "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/authenticationapi/settings"
@@ -40,6 +54,7 @@ import (
 	certificatesgroups "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/certificates/groups"
 	certificatesrevocationocspcertificates "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/certificates/revocation/ocspcertificates"
 	certificatesrevocationsettings "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/certificates/revocation/settings"
+	clusterreplication "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/cluster/replication"
 	clustersettings "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/cluster/settings"
 	clusterstatus "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/cluster/status"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/configstore"
@@ -97,6 +112,7 @@ import (
 	oauthtokenexchangetokengeneratormapping "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/oauth/tokenexchange/tokengeneratormapping"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/passwordcredentialvalidator"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/pingoneconnection"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/plugindescriptors"
 	protocolmetadatalifetimesettings "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/protocolmetadata/lifetimesettings"
 	protocolmetadatasigningsettings "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/protocolmetadata/signingsettings"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/redirectvalidation"
@@ -121,13 +137,18 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/tokenprocessortotokengeneratormapping"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/virtualhostnames"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/resourcewrapper"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/version"
+	"golang.org/x/net/http/httpproxy"
 )
 
 // Ensure the implementation satisfies the expected interfacesß
 var (
-	_ provider.Provider = &pingfederateProvider{}
+	_ provider.Provider                  = &pingfederateProvider{}
+	_ provider.ProviderWithListResources = &pingfederateProvider{}
+	_ provider.ProviderWithActions       = &pingfederateProvider{}
+	_ provider.ProviderWithFunctions     = &pingfederateProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -144,6 +165,9 @@ func NewTestProvider() provider.Provider {
 	return NewFactory("test")()
 }
 
+// Value of the product_version attribute that requests detection of the version from the server
+const productVersionAuto = "auto"
+
 // PingFederate ProviderModel maps provider schema data to a Go type.
 type pingfederateProviderModel struct {
 	HttpsHost                       types.String `tfsdk:"https_host"`
@@ -158,7 +182,26 @@ type pingfederateProviderModel struct {
 	InsecureTrustAllTls             types.Bool   `tfsdk:"insecure_trust_all_tls"`
 	CACertificatePEMFiles           types.Set    `tfsdk:"ca_certificate_pem_files"`
 	XBypassExternalValidationHeader types.Bool   `tfsdk:"x_bypass_external_validation_header"`
+	PrivateKeyJwtKeyPEMFile         types.String `tfsdk:"private_key_jwt_key_pem_file"`
+	PrivateKeyJwtKeyId              types.String `tfsdk:"private_key_jwt_key_id"`
+	PrivateKeyJwtSigningAlgorithm   types.String `tfsdk:"private_key_jwt_signing_algorithm"`
+	ClientCertificatePEMFile        types.String `tfsdk:"client_certificate_pem_file"`
+	ClientPrivateKeyPEMFile         types.String `tfsdk:"client_private_key_pem_file"`
+	HttpProxy                       types.String `tfsdk:"http_proxy"`
+	NoProxy                         types.Set    `tfsdk:"no_proxy"`
+	CustomHeaders                   types.Map    `tfsdk:"custom_headers"`
 	ProductVersion                  types.String `tfsdk:"product_version"`
+	ReadOnly                        types.Bool   `tfsdk:"read_only"`
+	AdoptExistingOnCreate           types.Bool   `tfsdk:"adopt_existing_on_create"`
+	RequestTimeoutSeconds           types.Int64  `tfsdk:"request_timeout_seconds"`
+	Retry                           types.Object `tfsdk:"retry"`
+}
+
+type pingfederateProviderRetryModel struct {
+	MaxAttempts          types.Int64 `tfsdk:"max_attempts"`
+	MinBackoffSeconds    types.Int64 `tfsdk:"min_backoff_seconds"`
+	MaxBackoffSeconds    types.Int64 `tfsdk:"max_backoff_seconds"`
+	RetryableStatusCodes types.Set   `tfsdk:"retryable_status_codes"`
 }
 
 // pingfederateProvider is the provider implementation.
@@ -184,6 +227,10 @@ func (p *pingfederateProvider) Schema(_ context.Context, _ provider.SchemaReques
 				MarkdownDescription: "Path for PingFederate Admin API. Default value can be set with the `PINGFEDERATE_PROVIDER_ADMIN_API_PATH` environment variable. If no value is supplied, the value used will be `/pf-admin-api/v1`.",
 				Optional:            true,
 			},
+			"adopt_existing_on_create": schema.BoolAttribute{
+				Description: "Set to true to adopt existing objects when creating resources, for example when moving an existing environment under Terraform. When a create fails because an object with the same ID already exists in PingFederate, the existing object is read and updated with the planned configuration, and a warning is raised instead of an error. Default value can be set with the `PINGFEDERATE_PROVIDER_ADOPT_EXISTING_ON_CREATE` environment variable.",
+				Optional:    true,
+			},
 			"access_token": schema.StringAttribute{
 				MarkdownDescription: "Access token for PingFederate Admin API. Cannot be used in conjunction with username and password, or oauth. Default value can be set with the `PINGFEDERATE_PROVIDER_ACCESS_TOKEN` environment variable.",
 				Optional:            true,
@@ -202,29 +249,56 @@ func (p *pingfederateProvider) Schema(_ context.Context, _ provider.SchemaReques
 				Description: "Paths to files containing PEM-encoded certificates to be trusted as root CAs when connecting to the PingFederate server over HTTPS. If not set, the host's root CA set will be used. Default value can be set with the `PINGFEDERATE_PROVIDER_CA_CERTIFICATE_PEM_FILES` environment variable, using commas to delimit multiple PEM files if necessary.",
 				Optional:    true,
 			},
+			"client_certificate_pem_file": schema.StringAttribute{
+				Description: "Path to a file containing a PEM-encoded client certificate to present for mutual TLS authentication when connecting to the PingFederate server over HTTPS. Must be set with `client_private_key_pem_file`. Can be used alone or in conjunction with basic authentication, access_token, or oauth. Default value can be set with the `PINGFEDERATE_PROVIDER_CLIENT_CERTIFICATE_PEM_FILE` environment variable.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_private_key_pem_file")),
+				},
+			},
+			"client_private_key_pem_file": schema.StringAttribute{
+				Description: "Path to a file containing the PEM-encoded private key for the client certificate used for mutual TLS authentication. Must be set with `client_certificate_pem_file`. Default value can be set with the `PINGFEDERATE_PROVIDER_CLIENT_PRIVATE_KEY_PEM_FILE` environment variable.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_certificate_pem_file")),
+				},
+			},
 			"client_id": schema.StringAttribute{
-				MarkdownDescription: "OAuth client ID for requesting access token. Default value can be set with the `PINGFEDERATE_PROVIDER_OAUTH_CLIENT_ID` environment variable.",
+				MarkdownDescription: "OAuth client ID for requesting access token. Must be set with either client_secret or private_key_jwt_key_pem_file. Default value can be set with the `PINGFEDERATE_PROVIDER_OAUTH_CLIENT_ID` environment variable.",
 				Optional:            true,
 				Validators: []validator.String{
 					stringvalidator.ConflictsWith(path.MatchRoot("access_token")),
 					stringvalidator.ConflictsWith(path.MatchRoot("username")),
 					stringvalidator.ConflictsWith(path.MatchRoot("password")),
-					stringvalidator.AlsoRequires(path.MatchRoot("client_secret")),
 					stringvalidator.AlsoRequires(path.MatchRoot("token_url")),
 				},
 			},
 			"client_secret": schema.StringAttribute{
-				MarkdownDescription: "OAuth client secret for requesting access token. Default value can be set with the `PINGFEDERATE_PROVIDER_OAUTH_CLIENT_SECRET` environment variable.",
+				MarkdownDescription: "OAuth client secret for requesting access token. Cannot be used in conjunction with private_key_jwt_key_pem_file. Default value can be set with the `PINGFEDERATE_PROVIDER_OAUTH_CLIENT_SECRET` environment variable.",
 				Optional:            true,
 				Sensitive:           true,
 				Validators: []validator.String{
 					stringvalidator.ConflictsWith(path.MatchRoot("access_token")),
 					stringvalidator.ConflictsWith(path.MatchRoot("username")),
 					stringvalidator.ConflictsWith(path.MatchRoot("password")),
+					stringvalidator.ConflictsWith(path.MatchRoot("private_key_jwt_key_pem_file")),
 					stringvalidator.AlsoRequires(path.MatchRoot("client_id")),
 					stringvalidator.AlsoRequires(path.MatchRoot("token_url")),
 				},
 			},
+			"custom_headers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Description: "Additional HTTP headers to include in every request to the PingFederate Admin API, for example an API key required by a gateway in front of the server. The `Authorization` header cannot be set. Default value can be set with the `PINGFEDERATE_PROVIDER_CUSTOM_HEADERS` environment variable, using commas to delimit multiple `name=value` pairs.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"http_proxy": schema.StringAttribute{
+				Description: "URL of the proxy to use for requests to the PingFederate server, including OAuth token requests. If not set, the proxy is determined from the standard `HTTPS_PROXY`, `HTTP_PROXY`, and `NO_PROXY` environment variables. Default value can be set with the `PINGFEDERATE_PROVIDER_HTTP_PROXY` environment variable.",
+				Optional:    true,
+			},
 			"insecure_trust_all_tls": schema.BoolAttribute{
 				Description: "Set to true to trust any certificate when connecting to the PingFederate server. This is insecure and should not be enabled outside of testing. Default value can be set with the `PINGFEDERATE_PROVIDER_INSECURE_TRUST_ALL_TLS` environment variable.",
 				Optional:    true,
@@ -238,7 +312,6 @@ func (p *pingfederateProvider) Schema(_ context.Context, _ provider.SchemaReques
 					setvalidator.ConflictsWith(path.MatchRoot("username")),
 					setvalidator.ConflictsWith(path.MatchRoot("password")),
 					setvalidator.AlsoRequires(path.MatchRoot("client_id")),
-					setvalidator.AlsoRequires(path.MatchRoot("client_secret")),
 					setvalidator.AlsoRequires(path.MatchRoot("token_url")),
 				},
 			},
@@ -250,7 +323,6 @@ func (p *pingfederateProvider) Schema(_ context.Context, _ provider.SchemaReques
 					stringvalidator.ConflictsWith(path.MatchRoot("username")),
 					stringvalidator.ConflictsWith(path.MatchRoot("password")),
 					stringvalidator.AlsoRequires(path.MatchRoot("client_id")),
-					stringvalidator.AlsoRequires(path.MatchRoot("client_secret")),
 				},
 			},
 			"username": schema.StringAttribute{
@@ -265,6 +337,14 @@ func (p *pingfederateProvider) Schema(_ context.Context, _ provider.SchemaReques
 					stringvalidator.AlsoRequires(path.MatchRoot("password")),
 				},
 			},
+			"no_proxy": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "Hosts, domains, IP addresses, or CIDR ranges that should be accessed directly rather than through `http_proxy`, using the same format as the standard `NO_PROXY` environment variable. Default value can be set with the `PINGFEDERATE_PROVIDER_NO_PROXY` environment variable, using commas to delimit multiple values.",
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.AlsoRequires(path.MatchRoot("http_proxy")),
+				},
+			},
 			"password": schema.StringAttribute{
 				MarkdownDescription: "Password for PingFederate Admin user. Must only be set with username. Cannot be used in conjunction with access_token, or oauth.  Default value can be set with the `PINGFEDERATE_PROVIDER_PASSWORD` environment variable.",
 				Optional:            true,
@@ -278,15 +358,89 @@ func (p *pingfederateProvider) Schema(_ context.Context, _ provider.SchemaReques
 					stringvalidator.AlsoRequires(path.MatchRoot("username")),
 				},
 			},
+			"private_key_jwt_key_pem_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the PEM-encoded RSA or EC private key used to sign JWT client assertions when authenticating to the token endpoint with the `private_key_jwt` client authentication method. Cannot be used in conjunction with client_secret. Default value can be set with the `PINGFEDERATE_PROVIDER_OAUTH_PRIVATE_KEY_JWT_KEY_PEM_FILE` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("access_token")),
+					stringvalidator.ConflictsWith(path.MatchRoot("username")),
+					stringvalidator.ConflictsWith(path.MatchRoot("password")),
+					stringvalidator.ConflictsWith(path.MatchRoot("client_secret")),
+					stringvalidator.AlsoRequires(path.MatchRoot("client_id")),
+					stringvalidator.AlsoRequires(path.MatchRoot("token_url")),
+				},
+			},
+			"private_key_jwt_key_id": schema.StringAttribute{
+				MarkdownDescription: "Key ID to include in the `kid` header of JWT client assertions. Default value can be set with the `PINGFEDERATE_PROVIDER_OAUTH_PRIVATE_KEY_JWT_KEY_ID` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("private_key_jwt_key_pem_file")),
+				},
+			},
+			"private_key_jwt_signing_algorithm": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Algorithm used to sign JWT client assertions. Options are `%s`. Default value can be set with the `PINGFEDERATE_PROVIDER_OAUTH_PRIVATE_KEY_JWT_SIGNING_ALGORITHM` environment variable. If no value is supplied, the value used will be `%s`.", strings.Join(oauth.SupportedSigningAlgorithms, "`, `"), oauth.DefaultSigningAlgorithm),
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(oauth.SupportedSigningAlgorithms...),
+					stringvalidator.AlsoRequires(path.MatchRoot("private_key_jwt_key_pem_file")),
+				},
+			},
 			"product_version": schema.StringAttribute{
-				Description: "Version of the PingFederate server being configured. Default value can be set with the `PINGFEDERATE_PROVIDER_PRODUCT_VERSION` environment variable.",
+				Description: "Version of the PingFederate server being configured. Set to `auto`, or leave unset, to detect the version from the PingFederate server when the provider is configured. If a version is set, the version reported by the server is still retrieved, and a warning is raised if the two disagree. The set version is used if the server version can't be retrieved. Versions newer than the latest version supported by the provider are accepted with a warning, and treated as the latest supported version. Default value can be set with the `PINGFEDERATE_PROVIDER_PRODUCT_VERSION` environment variable.",
 				Optional:    true,
 			},
+			"read_only": schema.BoolAttribute{
+				Description: "Set to true to prevent the provider from making any changes to the PingFederate server, for example when running scheduled drift detection plans with credentials that have write access. Reading resources, importing, and data sources continue to work, while any create, update, or delete, and any action that would make changes, fails before a request is sent to the server. Default value can be set with the `PINGFEDERATE_PROVIDER_READ_ONLY` environment variable.",
+				Optional:    true,
+			},
+			"request_timeout_seconds": schema.Int64Attribute{
+				Description: "The maximum number of seconds to wait for each individual request to the PingFederate Admin API, including reading the response. Each retry attempt is timed separately. If not set, individual requests are only limited by the `timeouts` configured on each resource. Default value can be set with the `PINGFEDERATE_PROVIDER_REQUEST_TIMEOUT_SECONDS` environment variable.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 			"x_bypass_external_validation_header": schema.BoolAttribute{
 				Description: "Header value in request for PingFederate. When set to `true`, connectivity checks for resources such as `pingfederate_data_store` will be skipped. Default value can be set with the `PINGFEDERATE_PROVIDER_X_BYPASS_EXTERNAL_VALIDATION_HEADER` environment variable.",
 				Optional:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"retry": schema.SingleNestedBlock{
+				Description: "Retry policy for PingFederate Admin API requests that fail with a connection error or a retryable HTTP status code. Failed requests are retried with exponential backoff. Requests that are not idempotent, such as those that create objects, may have been processed by the server before failing, so they are only retried when the server responds with status code `429` or `503` and a `Retry-After` header.",
+				Attributes: map[string]schema.Attribute{
+					"max_attempts": schema.Int64Attribute{
+						Description: fmt.Sprintf("The maximum number of attempts for each request, including the initial attempt. Set to `1` to disable retries. Default value can be set with the `PINGFEDERATE_PROVIDER_RETRY_MAX_ATTEMPTS` environment variable. If no value is supplied, the value used will be `%d`.", api.DefaultRetryMaxAttempts),
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"min_backoff_seconds": schema.Int64Attribute{
+						Description: fmt.Sprintf("The number of seconds to wait before the first retry. The wait time doubles for each subsequent retry. Default value can be set with the `PINGFEDERATE_PROVIDER_RETRY_MIN_BACKOFF_SECONDS` environment variable. If no value is supplied, the value used will be `%d`.", int(api.DefaultRetryMinBackoff.Seconds())),
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"max_backoff_seconds": schema.Int64Attribute{
+						Description: fmt.Sprintf("The maximum number of seconds to wait between retries. Default value can be set with the `PINGFEDERATE_PROVIDER_RETRY_MAX_BACKOFF_SECONDS` environment variable. If no value is supplied, the value used will be `%d`.", int(api.DefaultRetryMaxBackoff.Seconds())),
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"retryable_status_codes": schema.SetAttribute{
+						ElementType: types.Int64Type,
+						Description: "HTTP status codes that cause a request to be retried. Add `422` to retry requests rejected while the configuration is locked for replication; note that validation failures also return `422`, and will be retried as well. Default value can be set with the `PINGFEDERATE_PROVIDER_RETRY_STATUS_CODES` environment variable, using commas to delimit multiple status codes. If no value is supplied, the values used will be `429`, `500`, `502`, `503`, and `504`.",
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.ValueInt64sAre(int64validator.Between(100, 599)),
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -314,6 +468,206 @@ func addAttributeUnknownError(attribute, envVar string, diags *diag.Diagnostics)
 	)
 }
 
+func addRetryAttributeUnknownError(attribute, envVar string, diags *diag.Diagnostics) {
+	diags.AddAttributeError(
+		path.Root("retry").AtName(attribute),
+		providererror.InvalidProviderConfiguration,
+		fmt.Sprintf("retry.%s cannot be unknown. It can be set either in the configuration or with the %s environment variable", attribute, envVar),
+	)
+}
+
+// Determine the proxy to use for requests to the PingFederate server. If no proxy is configured for the provider,
+// the standard proxy environment variables are used.
+func getProxy(ctx context.Context, httpProxyConfig types.String, noProxyConfig types.Set, diags *diag.Diagnostics) func(*http.Request) (*url.URL, error) {
+	var httpProxy string
+	if httpProxyConfig.IsUnknown() {
+		addAttributeUnknownError("http_proxy", "PINGFEDERATE_PROVIDER_HTTP_PROXY", diags)
+		return nil
+	} else if httpProxyConfig.IsNull() {
+		httpProxy = os.Getenv("PINGFEDERATE_PROVIDER_HTTP_PROXY")
+	} else {
+		httpProxy = httpProxyConfig.ValueString()
+	}
+
+	var noProxy []string
+	if noProxyConfig.IsUnknown() {
+		addAttributeUnknownError("no_proxy", "PINGFEDERATE_PROVIDER_NO_PROXY", diags)
+		return nil
+	} else if noProxyConfig.IsNull() {
+		noProxyEnvVar := os.Getenv("PINGFEDERATE_PROVIDER_NO_PROXY")
+		if noProxyEnvVar != "" {
+			noProxy = strings.Split(noProxyEnvVar, ",")
+		}
+	} else {
+		diags.Append(noProxyConfig.ElementsAs(ctx, &noProxy, false)...)
+	}
+
+	if httpProxy == "" {
+		tflog.Info(ctx, "No http_proxy specified, using the proxy environment variables")
+		return http.ProxyFromEnvironment
+	}
+
+	proxyUrl, err := url.Parse(httpProxy)
+	if err != nil || proxyUrl.Scheme == "" || proxyUrl.Host == "" {
+		diags.AddAttributeError(path.Root("http_proxy"), providererror.InvalidProviderConfiguration,
+			"Failed to parse proxy URL '"+httpProxy+"'. Expected a URL such as 'http://proxy.example.com:3128'")
+		return nil
+	}
+
+	tflog.Info(ctx, "Using proxy for requests to PingFederate: "+proxyUrl.Redacted())
+	proxyConfig := &httpproxy.Config{
+		HTTPProxy:  httpProxy,
+		HTTPSProxy: httpProxy,
+		NoProxy:    strings.Join(noProxy, ","),
+	}
+	proxyFunc := proxyConfig.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxyFunc(req.URL)
+	}
+}
+
+// Retrieve the custom headers to send with every admin API request
+func getCustomHeaders(ctx context.Context, customHeadersConfig types.Map, diags *diag.Diagnostics) map[string]string {
+	customHeaders := map[string]string{}
+	if customHeadersConfig.IsUnknown() {
+		addAttributeUnknownError("custom_headers", "PINGFEDERATE_PROVIDER_CUSTOM_HEADERS", diags)
+		return customHeaders
+	} else if !customHeadersConfig.IsNull() {
+		diags.Append(customHeadersConfig.ElementsAs(ctx, &customHeaders, false)...)
+	} else if customHeadersEnvVar := os.Getenv("PINGFEDERATE_PROVIDER_CUSTOM_HEADERS"); customHeadersEnvVar != "" {
+		for _, customHeader := range strings.Split(customHeadersEnvVar, ",") {
+			name, value, found := strings.Cut(customHeader, "=")
+			name = strings.TrimSpace(name)
+			if !found || name == "" {
+				diags.AddError(providererror.InvalidProviderConfiguration,
+					"Failed to parse custom header from 'PINGFEDERATE_PROVIDER_CUSTOM_HEADERS' environment variable. Expected comma-delimited 'name=value' pairs")
+				continue
+			}
+			customHeaders[name] = value
+		}
+	}
+
+	for name := range customHeaders {
+		if strings.EqualFold(name, "Authorization") {
+			diags.AddAttributeError(path.Root("custom_headers"), providererror.InvalidProviderConfiguration,
+				"The Authorization header cannot be set in custom_headers. Use the provider authentication attributes instead")
+		}
+	}
+	return customHeaders
+}
+
+// Build the retry policy from the retry block, falling back to environment variables and then defaults
+func getRetryPolicy(ctx context.Context, retryConfig types.Object, diags *diag.Diagnostics) api.RetryPolicy {
+	retryPolicy := api.DefaultRetryPolicy()
+	var retryModel pingfederateProviderRetryModel
+	if !retryConfig.IsNull() && !retryConfig.IsUnknown() {
+		diags.Append(retryConfig.As(ctx, &retryModel, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return retryPolicy
+		}
+	}
+
+	if retryModel.MaxAttempts.IsUnknown() {
+		addRetryAttributeUnknownError("max_attempts", "PINGFEDERATE_PROVIDER_RETRY_MAX_ATTEMPTS", diags)
+	} else if !retryModel.MaxAttempts.IsNull() {
+		retryPolicy.MaxAttempts = int(retryModel.MaxAttempts.ValueInt64())
+	} else if envMaxAttempts, ok := getInt64EnvVar(ctx, "PINGFEDERATE_PROVIDER_RETRY_MAX_ATTEMPTS"); ok {
+		retryPolicy.MaxAttempts = int(envMaxAttempts)
+	}
+
+	if retryModel.MinBackoffSeconds.IsUnknown() {
+		addRetryAttributeUnknownError("min_backoff_seconds", "PINGFEDERATE_PROVIDER_RETRY_MIN_BACKOFF_SECONDS", diags)
+	} else if !retryModel.MinBackoffSeconds.IsNull() {
+		retryPolicy.MinBackoff = time.Duration(retryModel.MinBackoffSeconds.ValueInt64()) * time.Second
+	} else if envMinBackoff, ok := getInt64EnvVar(ctx, "PINGFEDERATE_PROVIDER_RETRY_MIN_BACKOFF_SECONDS"); ok {
+		retryPolicy.MinBackoff = time.Duration(envMinBackoff) * time.Second
+	}
+
+	if retryModel.MaxBackoffSeconds.IsUnknown() {
+		addRetryAttributeUnknownError("max_backoff_seconds", "PINGFEDERATE_PROVIDER_RETRY_MAX_BACKOFF_SECONDS", diags)
+	} else if !retryModel.MaxBackoffSeconds.IsNull() {
+		retryPolicy.MaxBackoff = time.Duration(retryModel.MaxBackoffSeconds.ValueInt64()) * time.Second
+	} else if envMaxBackoff, ok := getInt64EnvVar(ctx, "PINGFEDERATE_PROVIDER_RETRY_MAX_BACKOFF_SECONDS"); ok {
+		retryPolicy.MaxBackoff = time.Duration(envMaxBackoff) * time.Second
+	}
+
+	if retryModel.RetryableStatusCodes.IsUnknown() {
+		addRetryAttributeUnknownError("retryable_status_codes", "PINGFEDERATE_PROVIDER_RETRY_STATUS_CODES", diags)
+	} else if !retryModel.RetryableStatusCodes.IsNull() {
+		var statusCodes []int64
+		diags.Append(retryModel.RetryableStatusCodes.ElementsAs(ctx, &statusCodes, false)...)
+		retryPolicy.RetryableStatusCodes = nil
+		for _, statusCode := range statusCodes {
+			retryPolicy.RetryableStatusCodes = append(retryPolicy.RetryableStatusCodes, int(statusCode))
+		}
+	} else if envStatusCodes := os.Getenv("PINGFEDERATE_PROVIDER_RETRY_STATUS_CODES"); envStatusCodes != "" {
+		retryPolicy.RetryableStatusCodes = nil
+		for _, envStatusCode := range strings.Split(envStatusCodes, ",") {
+			statusCode, err := strconv.Atoi(strings.TrimSpace(envStatusCode))
+			if err != nil {
+				diags.AddError(providererror.InvalidProviderConfiguration,
+					"Failed to parse HTTP status code '"+envStatusCode+"' from 'PINGFEDERATE_PROVIDER_RETRY_STATUS_CODES' environment variable")
+				continue
+			}
+			retryPolicy.RetryableStatusCodes = append(retryPolicy.RetryableStatusCodes, statusCode)
+		}
+	}
+
+	if retryPolicy.MaxAttempts < 1 {
+		diags.AddAttributeError(path.Root("retry").AtName("max_attempts"), providererror.InvalidProviderConfiguration, "max_attempts must be at least 1")
+	}
+	if retryPolicy.MinBackoff > retryPolicy.MaxBackoff {
+		diags.AddAttributeError(path.Root("retry").AtName("min_backoff_seconds"), providererror.InvalidProviderConfiguration, "min_backoff_seconds cannot be greater than max_backoff_seconds")
+	}
+	return retryPolicy
+}
+
+func getInt64EnvVar(ctx context.Context, envVar string) (int64, bool) {
+	envValue := os.Getenv(envVar)
+	if envValue == "" {
+		return 0, false
+	}
+	value, err := strconv.ParseInt(envValue, 10, 64)
+	if err != nil {
+		tflog.Info(ctx, fmt.Sprintf("Failed to parse integer from '%s' environment variable, using the default value", envVar))
+		return 0, false
+	}
+	return value, true
+}
+
+// Retrieve the version of the PingFederate server and map it onto a supported version. The version is retrieved even
+// when product_version is set, to check that it matches the server. Failing to retrieve it is only an error when no
+// version is set.
+func detectProductVersion(ctx context.Context, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) (version.SupportedVersion, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	versionResponse, httpResp, err := apiClient.VersionAPI.GetVersion(config.AuthContext(ctx, providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &diags, "An error occurred while detecting the PingFederate version. Set the 'product_version' attribute or the 'PINGFEDERATE_PROVIDER_PRODUCT_VERSION' environment variable to use that version when the version can't be detected", err, httpResp)
+		return "", diags
+	}
+	return version.ParseServerVersion(versionResponse.GetVersion())
+}
+
+// Warn if the configured product version disagrees with the version reported by the server. If only a major-minor
+// version was configured, only the major-minor version is compared.
+func addProductVersionMismatchWarning(configuredVersion string, parsedVersion, detectedVersion version.SupportedVersion, diags *diag.Diagnostics) {
+	if len(strings.Split(configuredVersion, ".")) == 2 {
+		if version.MajorMinor(parsedVersion) == version.MajorMinor(detectedVersion) {
+			return
+		}
+	} else if parsedVersion == detectedVersion {
+		return
+	}
+
+	diags.AddAttributeWarning(
+		path.Root("product_version"),
+		providererror.InvalidProviderConfiguration,
+		fmt.Sprintf("The configured PingFederate version '%s' does not match the version reported by the PingFederate server '%s'. "+
+			"Version-specific attributes will be validated against the configured version. Set 'product_version' to '%s', or leave it unset to detect the version automatically.",
+			configuredVersion, string(detectedVersion), productVersionAuto),
+	)
+}
+
 func (p *pingfederateProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	// Retrieve provider data from configuration
 	var config pingfederateProviderModel
@@ -491,12 +845,82 @@ func (p *pingfederateProvider) Configure(ctx context.Context, req provider.Confi
 		}
 	}
 
+	// Check if the user has provided a private key for private_key_jwt OAuth client authentication
+	var privateKeyJwtKeyPemFile string
+	hasPrivateKeyJwtKeyPemFile := false
+	if config.PrivateKeyJwtKeyPEMFile.IsUnknown() {
+		// Cannot connect to PingFederate with an unknown value
+		addAttributeUnknownError("private_key_jwt_key_pem_file", "PINGFEDERATE_PROVIDER_OAUTH_PRIVATE_KEY_JWT_KEY_PEM_FILE", &resp.Diagnostics)
+	} else {
+		if config.PrivateKeyJwtKeyPEMFile.IsNull() {
+			privateKeyJwtKeyPemFile = os.Getenv("PINGFEDERATE_PROVIDER_OAUTH_PRIVATE_KEY_JWT_KEY_PEM_FILE")
+		} else {
+			privateKeyJwtKeyPemFile = config.PrivateKeyJwtKeyPEMFile.ValueString()
+		}
+		if privateKeyJwtKeyPemFile == "" {
+			tflog.Info(ctx, "Unable to find private_key_jwt_key_pem_file value")
+		} else {
+			hasPrivateKeyJwtKeyPemFile = true
+		}
+	}
+
+	var privateKeyJwtKeyId string
+	if config.PrivateKeyJwtKeyId.IsUnknown() {
+		// Cannot connect to PingFederate with an unknown value
+		addAttributeUnknownError("private_key_jwt_key_id", "PINGFEDERATE_PROVIDER_OAUTH_PRIVATE_KEY_JWT_KEY_ID", &resp.Diagnostics)
+	} else if config.PrivateKeyJwtKeyId.IsNull() {
+		privateKeyJwtKeyId = os.Getenv("PINGFEDERATE_PROVIDER_OAUTH_PRIVATE_KEY_JWT_KEY_ID")
+	} else {
+		privateKeyJwtKeyId = config.PrivateKeyJwtKeyId.ValueString()
+	}
+
+	var privateKeyJwtSigningAlgorithm string
+	if config.PrivateKeyJwtSigningAlgorithm.IsUnknown() {
+		// Cannot connect to PingFederate with an unknown value
+		addAttributeUnknownError("private_key_jwt_signing_algorithm", "PINGFEDERATE_PROVIDER_OAUTH_PRIVATE_KEY_JWT_SIGNING_ALGORITHM", &resp.Diagnostics)
+	} else if config.PrivateKeyJwtSigningAlgorithm.IsNull() {
+		privateKeyJwtSigningAlgorithm = os.Getenv("PINGFEDERATE_PROVIDER_OAUTH_PRIVATE_KEY_JWT_SIGNING_ALGORITHM")
+	} else {
+		privateKeyJwtSigningAlgorithm = config.PrivateKeyJwtSigningAlgorithm.ValueString()
+	}
+
+	// Check if the user has provided a client certificate for mutual TLS authentication
+	var clientCertificatePemFile string
+	if config.ClientCertificatePEMFile.IsUnknown() {
+		// Cannot connect to PingFederate with an unknown value
+		addAttributeUnknownError("client_certificate_pem_file", "PINGFEDERATE_PROVIDER_CLIENT_CERTIFICATE_PEM_FILE", &resp.Diagnostics)
+	} else if config.ClientCertificatePEMFile.IsNull() {
+		clientCertificatePemFile = os.Getenv("PINGFEDERATE_PROVIDER_CLIENT_CERTIFICATE_PEM_FILE")
+	} else {
+		clientCertificatePemFile = config.ClientCertificatePEMFile.ValueString()
+	}
+
+	var clientPrivateKeyPemFile string
+	if config.ClientPrivateKeyPEMFile.IsUnknown() {
+		// Cannot connect to PingFederate with an unknown value
+		addAttributeUnknownError("client_private_key_pem_file", "PINGFEDERATE_PROVIDER_CLIENT_PRIVATE_KEY_PEM_FILE", &resp.Diagnostics)
+	} else if config.ClientPrivateKeyPEMFile.IsNull() {
+		clientPrivateKeyPemFile = os.Getenv("PINGFEDERATE_PROVIDER_CLIENT_PRIVATE_KEY_PEM_FILE")
+	} else {
+		clientPrivateKeyPemFile = config.ClientPrivateKeyPEMFile.ValueString()
+	}
+
+	hasClientCertificate := clientCertificatePemFile != "" || clientPrivateKeyPemFile != ""
+	if hasClientCertificate {
+		if clientCertificatePemFile == "" {
+			addAuthAttributeDiagsError("client_certificate_pem_file", "mutual TLS", "PINGFEDERATE_PROVIDER_CLIENT_CERTIFICATE_PEM_FILE", resp)
+		}
+		if clientPrivateKeyPemFile == "" {
+			addAuthAttributeDiagsError("client_private_key_pem_file", "mutual TLS", "PINGFEDERATE_PROVIDER_CLIENT_PRIVATE_KEY_PEM_FILE", resp)
+		}
+	}
+
 	// Validate the configuration
-	if !hasUsername && !hasPassword && !hasAccessToken && !hasClientId && !hasClientSecret && !hasScopes && !hasTokenUrl {
+	if !hasUsername && !hasPassword && !hasAccessToken && !hasClientId && !hasClientSecret && !hasPrivateKeyJwtKeyPemFile && !hasScopes && !hasTokenUrl && !hasClientCertificate {
 		resp.Diagnostics.AddError(
 			providererror.InvalidProviderConfiguration,
-			"Unable to find username and password, access_token, or OAuth required properties for configuration. "+
-				"username and password, access_token, or oauth configuration required values were not supplied. Either set them in the configuration or use the PINGFEDERATE_PROVIDER_* environment variables.",
+			"Unable to find username and password, access_token, OAuth, or client certificate required properties for configuration. "+
+				"username and password, access_token, oauth, or client certificate configuration required values were not supplied. Either set them in the configuration or use the PINGFEDERATE_PROVIDER_* environment variables.",
 		)
 	}
 
@@ -510,7 +934,7 @@ func (p *pingfederateProvider) Configure(ctx context.Context, req provider.Confi
 	}
 
 	// User cannot provide username and password, OAuth configuration
-	if (hasUsername || hasPassword) && (hasClientId || hasClientSecret || hasScopes || hasTokenUrl) {
+	if (hasUsername || hasPassword) && (hasClientId || hasClientSecret || hasPrivateKeyJwtKeyPemFile || hasScopes || hasTokenUrl) {
 		resp.Diagnostics.AddError(
 			providererror.InvalidProviderConfiguration,
 			"Username and password cannot be used with OAuth configuration properties. "+
@@ -519,7 +943,7 @@ func (p *pingfederateProvider) Configure(ctx context.Context, req provider.Confi
 	}
 
 	// User cannot provide access token, OAuth configuration
-	if hasAccessToken && (hasClientId || hasClientSecret || hasScopes || hasTokenUrl) {
+	if hasAccessToken && (hasClientId || hasClientSecret || hasPrivateKeyJwtKeyPemFile || hasScopes || hasTokenUrl) {
 		resp.Diagnostics.AddError(
 			providererror.InvalidProviderConfiguration,
 			"Access token cannot be used with OAuth configuration "+
@@ -529,7 +953,7 @@ func (p *pingfederateProvider) Configure(ctx context.Context, req provider.Confi
 
 	hasBasicAuth := hasUsername || hasPassword
 	hasAccessTokenAuth := hasAccessToken
-	hasOauthConfig := hasClientId || hasClientSecret || hasTokenUrl
+	hasOauthConfig := hasClientId || hasClientSecret || hasPrivateKeyJwtKeyPemFile || hasTokenUrl
 	// If user has not provided an OAuth configuration or access token, they must provide username and password
 	if (!hasOauthConfig || !hasAccessTokenAuth) && hasBasicAuth {
 		if username == "" {
@@ -546,8 +970,19 @@ func (p *pingfederateProvider) Configure(ctx context.Context, req provider.Confi
 		if clientId == "" {
 			addAuthAttributeDiagsError("client_id", "OAuth", "PINGFEDERATE_PROVIDER_OAUTH_CLIENT_ID", resp)
 		}
-		if clientSecret == "" {
-			addAuthAttributeDiagsError("client_secret", "OAuth", "PINGFEDERATE_PROVIDER_OAUTH_CLIENT_SECRET", resp)
+		if clientSecret == "" && privateKeyJwtKeyPemFile == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("client_secret"),
+				providererror.InvalidProviderConfiguration,
+				"Either client_secret or private_key_jwt_key_pem_file must be set when using OAuth authentication. Either set one of them in the configuration or use the PINGFEDERATE_PROVIDER_OAUTH_CLIENT_SECRET or PINGFEDERATE_PROVIDER_OAUTH_PRIVATE_KEY_JWT_KEY_PEM_FILE environment variable.",
+			)
+		}
+		if clientSecret != "" && privateKeyJwtKeyPemFile != "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("private_key_jwt_key_pem_file"),
+				providererror.InvalidProviderConfiguration,
+				"client_secret and private_key_jwt_key_pem_file cannot both be used for OAuth authentication. Remove one of them from the configuration, or unset the PINGFEDERATE_PROVIDER_OAUTH_CLIENT_SECRET or PINGFEDERATE_PROVIDER_OAUTH_PRIVATE_KEY_JWT_KEY_PEM_FILE environment variable.",
+			)
 		}
 		if tokenUrl == "" {
 			addAuthAttributeDiagsError("token_url", "OAuth", "PINGFEDEATE_PROVIDER_OAUTH_TOKEN_URL", resp)
@@ -557,19 +992,21 @@ func (p *pingfederateProvider) Configure(ctx context.Context, req provider.Confi
 		}
 	}
 
-	// User must provide a product version to the provider
+	// If no product version is provided, or it is set to "auto", it will be detected from the server
 	var productVersion string
 	var parsedProductVersion version.SupportedVersion
 	var err error
-	if !config.ProductVersion.IsUnknown() && !config.ProductVersion.IsNull() {
+	if config.ProductVersion.IsUnknown() {
+		// Cannot determine version-specific behavior with an unknown value
+		addAttributeUnknownError("product_version", "PINGFEDERATE_PROVIDER_PRODUCT_VERSION", &resp.Diagnostics)
+	} else if !config.ProductVersion.IsNull() {
 		productVersion = config.ProductVersion.ValueString()
 	} else {
 		productVersion = os.Getenv("PINGFEDERATE_PROVIDER_PRODUCT_VERSION")
 	}
 
-	if productVersion == "" {
-		addAttributeRequiredError("product_version", "PINGFEDERATE_PROVIDER_PRODUCT_VERSION", &resp.Diagnostics)
-	} else {
+	autoDetectProductVersion := productVersion == "" || strings.EqualFold(productVersion, productVersionAuto)
+	if !autoDetectProductVersion {
 		// Validate the PingFederate version
 		parsedProductVersion, diags = version.Parse(productVersion)
 		resp.Diagnostics.Append(diags...)
@@ -620,6 +1057,18 @@ func (p *pingfederateProvider) Configure(ctx context.Context, req provider.Confi
 		}
 	}
 
+	var clientCertificates []tls.Certificate
+	if clientCertificatePemFile != "" && clientPrivateKeyPemFile != "" {
+		tflog.Info(ctx, "Loading client certificate for mutual TLS authentication from file: "+clientCertificatePemFile)
+		clientCertificate, err := tls.LoadX509KeyPair(filepath.Clean(clientCertificatePemFile), filepath.Clean(clientPrivateKeyPemFile))
+		if err != nil {
+			resp.Diagnostics.AddError(providererror.InvalidProviderConfiguration,
+				"Failed to load client certificate and private key from PEM files: "+clientCertificatePemFile+", "+clientPrivateKeyPemFile+". "+err.Error())
+		} else {
+			clientCertificates = append(clientCertificates, clientCertificate)
+		}
+	}
+
 	var xBypassExternalValidation bool
 	var xBypassExternalValidationErr error
 	if !config.XBypassExternalValidationHeader.IsUnknown() && !config.XBypassExternalValidationHeader.IsNull() {
@@ -632,6 +1081,50 @@ func (p *pingfederateProvider) Configure(ctx context.Context, req provider.Confi
 		}
 	}
 
+	var readOnly bool
+	if !config.ReadOnly.IsUnknown() && !config.ReadOnly.IsNull() {
+		readOnly = config.ReadOnly.ValueBool()
+	} else {
+		readOnly, err = strconv.ParseBool(os.Getenv("PINGFEDERATE_PROVIDER_READ_ONLY"))
+		if err != nil {
+			readOnly = false
+			tflog.Info(ctx, "Failed to parse boolean from 'PINGFEDERATE_PROVIDER_READ_ONLY' environment variable, defaulting 'read_only' to false")
+		}
+	}
+
+	var adoptExistingOnCreate bool
+	if !config.AdoptExistingOnCreate.IsUnknown() && !config.AdoptExistingOnCreate.IsNull() {
+		adoptExistingOnCreate = config.AdoptExistingOnCreate.ValueBool()
+	} else {
+		adoptExistingOnCreate, err = strconv.ParseBool(os.Getenv("PINGFEDERATE_PROVIDER_ADOPT_EXISTING_ON_CREATE"))
+		if err != nil {
+			adoptExistingOnCreate = false
+			tflog.Info(ctx, "Failed to parse boolean from 'PINGFEDERATE_PROVIDER_ADOPT_EXISTING_ON_CREATE' environment variable, defaulting 'adopt_existing_on_create' to false")
+		}
+	}
+
+	retryPolicy := getRetryPolicy(ctx, config.Retry, &resp.Diagnostics)
+
+	var requestTimeout time.Duration
+	if config.RequestTimeoutSeconds.IsUnknown() {
+		addAttributeUnknownError("request_timeout_seconds", "PINGFEDERATE_PROVIDER_REQUEST_TIMEOUT_SECONDS", &resp.Diagnostics)
+	} else if !config.RequestTimeoutSeconds.IsNull() {
+		requestTimeout = time.Duration(config.RequestTimeoutSeconds.ValueInt64()) * time.Second
+	} else if envRequestTimeout, ok := getInt64EnvVar(ctx, "PINGFEDERATE_PROVIDER_REQUEST_TIMEOUT_SECONDS"); ok && envRequestTimeout > 0 {
+		requestTimeout = time.Duration(envRequestTimeout) * time.Second
+	}
+	proxy := getProxy(ctx, config.HttpProxy, config.NoProxy, &resp.Diagnostics)
+	customHeaders := getCustomHeaders(ctx, config.CustomHeaders, &resp.Diagnostics)
+
+	var clientAssertionSigner *oauth.ClientAssertionSigner
+	if hasOauthConfig && privateKeyJwtKeyPemFile != "" {
+		clientAssertionSigner, err = oauth.NewClientAssertionSigner(privateKeyJwtKeyPemFile, privateKeyJwtKeyId, privateKeyJwtSigningAlgorithm, clientId, tokenUrl)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("private_key_jwt_key_pem_file"), providererror.InvalidProviderConfiguration,
+				"Failed to configure private_key_jwt client authentication: "+err.Error())
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -644,8 +1137,10 @@ func (p *pingfederateProvider) Configure(ctx context.Context, req provider.Confi
 	// type Configure methods.
 	var resourceConfig internaltypes.ResourceConfiguration
 	providerConfig := internaltypes.ProviderConfiguration{
-		HttpsHost:      httpsHost,
-		ProductVersion: parsedProductVersion,
+		HttpsHost:             httpsHost,
+		ProductVersion:        parsedProductVersion,
+		ReadOnly:              readOnly,
+		AdoptExistingOnCreate: adoptExistingOnCreate,
 	}
 
 	if username != "" {
@@ -662,7 +1157,9 @@ func (p *pingfederateProvider) Configure(ctx context.Context, req provider.Confi
 
 	if hasOauthConfig {
 		providerConfig.ClientId = &clientId
-		providerConfig.ClientSecret = &clientSecret
+		if clientSecret != "" {
+			providerConfig.ClientSecret = &clientSecret
+		}
 		providerConfig.Scopes = scopes
 		providerConfig.TokenUrl = &tokenUrl
 	}
@@ -671,6 +1168,9 @@ func (p *pingfederateProvider) Configure(ctx context.Context, req provider.Confi
 	clientConfig := client.NewConfiguration()
 	clientConfig.DefaultHeader["X-Xsrf-Header"] = "PingFederate"
 	clientConfig.DefaultHeader["X-BypassExternalValidation"] = strconv.FormatBool(xBypassExternalValidation)
+	for name, value := range customHeaders {
+		clientConfig.DefaultHeader[name] = value
+	}
 	clientConfig.Servers = client.ServerConfigurations{
 		{
 			URL: httpsHost + adminApiPath,
@@ -678,28 +1178,74 @@ func (p *pingfederateProvider) Configure(ctx context.Context, req provider.Confi
 	}
 	// #nosec G402
 	tr := &http.Transport{
+		Proxy: proxy,
 		TLSClientConfig: &tls.Config{
 			InsecureSkipVerify: insecureTrustAllTls,
 			RootCAs:            caCertPool,
+			Certificates:       clientCertificates,
 		},
 	}
-	httpClient := &http.Client{Transport: tr}
+	// Serialize writes to endpoints that conflict with each other, using the full path of the admin API on the server
+	var serverPath string
+	if serverUrl, err := url.Parse(httpsHost + adminApiPath); err == nil {
+		serverPath = serverUrl.Path
+	}
+	httpClient := &http.Client{Transport: api.NewRetryTransport(api.NewLockTransport(api.NewTraceTransport(api.NewTimeoutTransport(tr, requestTimeout)), serverPath, api.SerializedEndpoints), retryPolicy)}
+	if readOnly {
+		// Reject any changes before they reach the retry transport, so they fail immediately
+		tflog.Info(ctx, "Provider is in read-only mode, requests that would modify PingFederate will be rejected")
+		httpClient.Transport = api.NewReadOnlyTransport(httpClient.Transport)
+	}
 	resourceConfig.ProviderConfig.Transport = tr
+	resourceConfig.ProviderConfig.PluginDescriptors = plugindescriptor.NewCache()
+	resourceConfig.ProviderConfig.AuthenticationPolicyContracts = policycontract.NewCache()
+	if hasOauthConfig {
+		// Share a single caching token source across all resources, so tokens are only requested when needed
+		resourceConfig.ProviderConfig.OAuthTokenSource = oauth.NewCachingTokenSource(ctx, oauth.TokenSourceConfig{
+			HttpClient:            &http.Client{Transport: api.NewRetryTransport(api.NewTimeoutTransport(tr, requestTimeout), retryPolicy)},
+			TokenUrl:              tokenUrl,
+			ClientId:              clientId,
+			ClientSecret:          clientSecret,
+			Scopes:                scopes,
+			ClientAssertionSigner: clientAssertionSigner,
+		})
+	}
 	clientConfig.HTTPClient = httpClient
+	resourceConfig.ApiClient = client.NewAPIClient(clientConfig)
+
+	// Compare the configured product version with the version reported by the server, or use the reported
+	// version if none was configured
+	detectedProductVersion, diags := detectProductVersion(ctx, resourceConfig.ApiClient, resourceConfig.ProviderConfig)
+	if autoDetectProductVersion {
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		parsedProductVersion = detectedProductVersion
+		productVersion = string(detectedProductVersion)
+		resourceConfig.ProviderConfig.ProductVersion = detectedProductVersion
+		tflog.Info(ctx, "Detected PingFederate version from server: "+productVersion)
+	} else if diags.HasError() {
+		tflog.Warn(ctx, "Unable to detect the PingFederate version from the server, using the configured product_version: "+productVersion)
+	} else {
+		addProductVersionMismatchWarning(productVersion, parsedProductVersion, detectedProductVersion, &resp.Diagnostics)
+	}
+
 	userAgentSuffix := fmt.Sprintf("terraform-provider-pingfederate/%s %s", p.version, productVersion)
 	if userAgentExtraSuffix != "" {
 		userAgentSuffix += fmt.Sprintf(" %s", userAgentExtraSuffix)
 	}
 	clientConfig.UserAgentSuffix = pointers.String(userAgentSuffix)
-	resourceConfig.ApiClient = client.NewAPIClient(clientConfig)
 	resp.ResourceData = resourceConfig
 	resp.DataSourceData = resourceConfig
+	resp.ListResourceData = resourceConfig
+	resp.ActionData = resourceConfig
 	tflog.Info(ctx, "Configured PingFederate client", map[string]interface{}{"success": true})
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *pingfederateProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{
+	return append([]func() datasource.DataSource{
 		administrativeaccount.AdministrativeAccountDataSource,
 		authenticationapiapplication.AuthenticationApiApplicationDataSource,
 		authenticationapisettings.AuthenticationApiSettingsDataSource,
@@ -742,12 +1288,12 @@ func (p *pingfederateProvider) DataSources(_ context.Context) []func() datasourc
 		spauthenticationpolicycontractmapping.SpAuthenticationPolicyContractMappingDataSource,
 		tokenprocessortotokengeneratormapping.TokenProcessorToTokenGeneratorMappingDataSource,
 		virtualhostnames.VirtualHostNamesDataSource,
-	}
+	}, plugindescriptors.DataSources()...)
 }
 
 // Resources defines the resources implemented in the provider.
 func (p *pingfederateProvider) Resources(_ context.Context) []func() resource.Resource {
-	return []func() resource.Resource{
+	return resourcewrapper.WrapAll([]func() resource.Resource{
 		administrativeaccount.AdministrativeAccountResource,
 		authenticationapiapplication.AuthenticationApiApplicationResource,
 		authenticationapisettings.AuthenticationApiSettingsResource,
@@ -762,6 +1308,7 @@ func (p *pingfederateProvider) Resources(_ context.Context) []func() resource.Re
 		certificatesgroups.CertificatesGroupResource,
 		certificatesrevocationocspcertificates.CertificatesRevocationOcspCertificateResource,
 		certificatesrevocationsettings.CertificatesRevocationSettingsResource,
+		clusterreplication.ClusterReplicationResource,
 		clustersettings.ClusterSettingsResource,
 		configstore.ConfigStoreResource,
 		configurationencryptionkeysrotate.ConfigurationEncryptionKeysRotateResource,
@@ -838,5 +1385,46 @@ func (p *pingfederateProvider) Resources(_ context.Context) []func() resource.Re
 		sptargeturlmappings.SpTargetUrlMappingsResource,
 		tokenprocessortotokengeneratormapping.TokenProcessorToTokenGeneratorMappingResource,
 		virtualhostnames.VirtualHostNamesResource,
+	})
+}
+
+// ListResources defines the list resources implemented in the provider.
+func (p *pingfederateProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		datastore.DataStoreListResource,
+		idpadapter.IdpAdapterListResource,
+		idpspconnection.IdpSpConnectionListResource,
+		keypairsigning.KeypairsSigningKeyListResource,
+		keypairssslclient.KeypairsSslClientKeyListResource,
+		keypairssslserver.KeypairsSslServerKeyListResource,
+		oauthaccesstokenmanager.OauthAccessTokenManagerListResource,
+		oauthclient.OauthClientListResource,
+		passwordcredentialvalidator.PasswordCredentialValidatorListResource,
+		spadapters.SpAdapterListResource,
+		spidpconnection.SpIdpConnectionListResource,
+	}
+}
+
+// Actions defines the actions implemented in the provider.
+func (p *pingfederateProvider) Actions(_ context.Context) []func() action.Action {
+	return resourcewrapper.WrapAllActions([]func() action.Action{
+		administrativeaccount.AdministrativeAccountPasswordResetAction,
+		clusterreplication.ClusterReplicationAction,
+		configurationencryptionkeysrotate.ConfigurationEncryptionKeysRotateAction,
+		keypairssslclientcsr.KeypairsSslClientCsrExportAction,
+		keypairssslservercsr.KeypairsSslServerCsrExportAction,
+		serversettingssystemkeysrotate.ServerSettingsSystemKeysRotateAction,
+	})
+}
+
+// Functions defines the functions implemented in the provider.
+func (p *pingfederateProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.AttributeContractFulfillmentFunction,
+		functions.CertificateSha1FingerprintFunction,
+		functions.CertificateSha256FingerprintFunction,
+		functions.CertificatesEquivalentFunction,
+		functions.NameToIdFunction,
+		functions.NormalizeCertificateFunction,
 	}
 }
@@ -0,0 +1,51 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"slices"
+)
+
+// Result and validation error IDs returned by PingFederate when an object with the same ID already exists
+var alreadyExistsErrorIds = []string{
+	"resource_already_exists",
+	"duplicate_id",
+	"duplicate_key",
+	"id_already_exists",
+}
+
+// CreateConflict records whether PingFederate rejected a create because an object with the same ID already exists
+type CreateConflict struct {
+	detected bool
+}
+
+type createConflictKey struct{}
+
+// WithCreateConflictDetection returns a context in which error responses indicating that an object already exists
+// are recorded by RecordCreateConflict, and the CreateConflict they are recorded in
+func WithCreateConflictDetection(ctx context.Context) (context.Context, *CreateConflict) {
+	conflict := &CreateConflict{}
+	return context.WithValue(ctx, createConflictKey{}, conflict), conflict
+}
+
+// Detected returns true if an error response indicating that an object already exists was recorded
+func (c *CreateConflict) Detected() bool {
+	return c != nil && c.detected
+}
+
+// RecordCreateConflict records an error response in the CreateConflict of the context, if any, when its status
+// and any of its result or validation error IDs indicate that the object already exists
+func RecordCreateConflict(ctx context.Context, statusCode int, errorIds ...string) {
+	conflict, ok := ctx.Value(createConflictKey{}).(*CreateConflict)
+	if !ok || (statusCode != http.StatusUnprocessableEntity && statusCode != http.StatusConflict) {
+		return
+	}
+	for _, errorId := range errorIds {
+		if slices.Contains(alreadyExistsErrorIds, errorId) {
+			conflict.detected = true
+			return
+		}
+	}
+}
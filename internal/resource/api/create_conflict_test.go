@@ -0,0 +1,67 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/api"
+)
+
+func TestRecordCreateConflict(t *testing.T) {
+	testCases := []struct {
+		name       string
+		statusCode int
+		errorIds   []string
+		detect     bool
+		expected   bool
+	}{
+		{
+			name:       "already exists validation error",
+			statusCode: http.StatusUnprocessableEntity,
+			errorIds:   []string{"validation_error", "duplicate_id"},
+			detect:     true,
+			expected:   true,
+		},
+		{
+			name:       "already exists result",
+			statusCode: http.StatusConflict,
+			errorIds:   []string{"resource_already_exists"},
+			detect:     true,
+			expected:   true,
+		},
+		{
+			name:       "other validation error",
+			statusCode: http.StatusUnprocessableEntity,
+			errorIds:   []string{"validation_error", "validation_required_value"},
+			detect:     true,
+		},
+		{
+			name:       "already exists error ID with another status",
+			statusCode: http.StatusBadRequest,
+			errorIds:   []string{"duplicate_id"},
+			detect:     true,
+		},
+		{
+			name:       "detection not enabled",
+			statusCode: http.StatusUnprocessableEntity,
+			errorIds:   []string{"duplicate_id"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+			var conflict *api.CreateConflict
+			if testCase.detect {
+				ctx, conflict = api.WithCreateConflictDetection(ctx)
+			}
+			api.RecordCreateConflict(ctx, testCase.statusCode, testCase.errorIds...)
+			if conflict.Detected() != testCase.expected {
+				t.Errorf("expected detected %t, got %t", testCase.expected, conflict.Detected())
+			}
+		})
+	}
+}
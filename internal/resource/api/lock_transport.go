@@ -0,0 +1,118 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Admin API endpoints where concurrent writes conflict with each other. Singleton settings endpoints are
+// replaced in full by each PUT and often reference objects managed by other resources under the same endpoint,
+// such as a default policy, so all writes under each endpoint are serialized. Writes to other endpoints run in parallel.
+var SerializedEndpoints = []string{
+	"/authenticationApi",
+	"/captchaProviders",
+	"/keyPairs/signing",
+	"/keyPairs/sslClient",
+	"/keyPairs/sslServer",
+	"/notificationPublishers",
+	"/oauth/accessTokenManagers",
+	"/oauth/accessTokenMappings",
+	"/oauth/authServerSettings",
+	"/oauth/cibaServerPolicy",
+	"/oauth/clientSettings",
+	"/oauth/openIdConnect",
+	"/oauth/tokenExchange",
+	"/serverSettings",
+	"/sp/targetUrlMappings",
+}
+
+// EndpointLocks is a registry of locks keyed by PingFederate server and admin API endpoint
+type EndpointLocks struct {
+	mutex sync.Mutex
+	locks map[string]chan struct{}
+}
+
+// The registry is shared by all provider instances, so that aliased providers configuring the same
+// PingFederate server are also serialized
+var endpointLocks = &EndpointLocks{
+	locks: map[string]chan struct{}{},
+}
+
+// Lock acquires the lock for the given key, and returns a function that releases it. An error is returned
+// if the context is done before the lock is acquired.
+func (l *EndpointLocks) Lock(ctx context.Context, key string) (func(), error) {
+	l.mutex.Lock()
+	lock, ok := l.locks[key]
+	if !ok {
+		lock = make(chan struct{}, 1)
+		l.locks[key] = lock
+	}
+	l.mutex.Unlock()
+
+	select {
+	case lock <- struct{}{}:
+		return func() { <-lock }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+type lockTransport struct {
+	base         http.RoundTripper
+	adminApiPath string
+	endpoints    []string
+	locks        *EndpointLocks
+}
+
+// NewLockTransport wraps an http.RoundTripper so that write requests to the given admin API endpoints are
+// serialized per PingFederate server and endpoint. Read requests are never serialized.
+func NewLockTransport(base http.RoundTripper, adminApiPath string, endpoints []string) http.RoundTripper {
+	return &lockTransport{
+		base:         base,
+		adminApiPath: strings.TrimSuffix(adminApiPath, "/"),
+		endpoints:    endpoints,
+		locks:        endpointLocks,
+	}
+}
+
+func (t *lockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if isReadRequest(req) {
+		return t.base.RoundTrip(req)
+	}
+
+	endpoint := t.serializedEndpoint(req.URL.Path)
+	if endpoint == "" {
+		return t.base.RoundTrip(req)
+	}
+
+	key := req.URL.Host + t.adminApiPath + endpoint
+	tflog.Debug(req.Context(), "Waiting for lock on serialized admin API endpoint", map[string]interface{}{
+		"endpoint": key,
+	})
+	unlock, err := t.locks.Lock(req.Context(), key)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	return t.base.RoundTrip(req)
+}
+
+// Get the serialized endpoint that a request path falls under, or an empty string if the path is not serialized
+func (t *lockTransport) serializedEndpoint(requestPath string) string {
+	apiPath, ok := strings.CutPrefix(requestPath, t.adminApiPath)
+	if !ok {
+		return ""
+	}
+	for _, endpoint := range t.endpoints {
+		if apiPath == endpoint || strings.HasPrefix(apiPath, endpoint+"/") {
+			return endpoint
+		}
+	}
+	return ""
+}
@@ -0,0 +1,38 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Error returned for requests that would modify PingFederate while the provider is in read-only mode
+var ErrReadOnly = errors.New("the provider is configured with read_only set to true, so no changes can be made to PingFederate")
+
+type readOnlyTransport struct {
+	base http.RoundTripper
+}
+
+// NewReadOnlyTransport wraps an http.RoundTripper so that any request other than GET, HEAD, or OPTIONS fails
+// with ErrReadOnly without being sent to the server.
+func NewReadOnlyTransport(base http.RoundTripper) http.RoundTripper {
+	return &readOnlyTransport{
+		base: base,
+	}
+}
+
+func (t *readOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isReadRequest(req) {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, fmt.Errorf("%s %s: %w", req.Method, req.URL.Path, ErrReadOnly)
+	}
+	return t.base.RoundTrip(req)
+}
+
+func isReadRequest(req *http.Request) bool {
+	return req.Method == http.MethodGet || req.Method == http.MethodHead || req.Method == http.MethodOptions
+}
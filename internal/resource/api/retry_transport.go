@@ -0,0 +1,153 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	DefaultRetryMaxAttempts = maxRetries
+	DefaultRetryMinBackoff  = time.Second
+	DefaultRetryMaxBackoff  = 30 * time.Second
+)
+
+// Status codes retried by default. 422 is not included, since it is also used for validation errors,
+// but it can be added to the retryable status codes to retry replication lock failures.
+var DefaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// Policy for retrying transient failures of admin API requests
+type RetryPolicy struct {
+	MaxAttempts          int
+	MinBackoff           time.Duration
+	MaxBackoff           time.Duration
+	RetryableStatusCodes []int
+}
+
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:          DefaultRetryMaxAttempts,
+		MinBackoff:           DefaultRetryMinBackoff,
+		MaxBackoff:           DefaultRetryMaxBackoff,
+		RetryableStatusCodes: slices.Clone(DefaultRetryableStatusCodes),
+	}
+}
+
+type retryTransport struct {
+	base   http.RoundTripper
+	policy RetryPolicy
+}
+
+// NewRetryTransport wraps an http.RoundTripper so that requests failing with a connection error or a
+// retryable status code are retried with exponential backoff, according to the given policy. Requests that are not
+// idempotent are only retried when the server asks for them to be retried later, see isRetryable.
+func NewRetryTransport(base http.RoundTripper, policy RetryPolicy) http.RoundTripper {
+	if policy.MaxAttempts < 1 {
+		policy.MaxAttempts = 1
+	}
+	return &retryTransport{
+		base:   base,
+		policy: policy,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// The request body can't be replayed, so the request can't be retried
+		return t.base.RoundTrip(req)
+	}
+
+	ctx := req.Context()
+	backOffTime := t.policy.MinBackoff
+	var resp *http.Response
+	var err error
+	for attempt := 1; ; attempt++ {
+		attemptReq := req
+		if attempt > 1 && req.Body != nil && req.Body != http.NoBody {
+			// The request body has been consumed by the previous attempt
+			attemptReq = req.Clone(ctx)
+			attemptReq.Body, err = req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err = t.base.RoundTrip(attemptReq)
+		if attempt >= t.policy.MaxAttempts || !t.isRetryable(req, resp, err) || ctx.Err() != nil {
+			return resp, err
+		}
+
+		waitTime := min(retryAfter(resp, backOffTime), t.policy.MaxBackoff)
+		if err != nil {
+			tflog.Info(ctx, fmt.Sprintf("%s %s attempt %d failed: %v, backing off by %s.", req.Method, req.URL.Path, attempt, err, waitTime.String()))
+		} else {
+			tflog.Info(ctx, fmt.Sprintf("%s %s attempt %d failed with HTTP status code %d, backing off by %s.", req.Method, req.URL.Path, attempt, resp.StatusCode, waitTime.String()))
+			// Drain and close the failed response so the connection can be reused
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(waitTime)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		backOffTime = min(backOffTime*2, t.policy.MaxBackoff)
+	}
+}
+
+func (t *retryTransport) isRetryable(req *http.Request, resp *http.Response, err error) bool {
+	if !isIdempotent(req) {
+		// Requests such as creates may have reached the server before failing, and repeating them would fail because
+		// the object already exists, or create a duplicate. They are only retried when the server has rejected them
+		// without processing them and asked for them to be retried later.
+		return err == nil && resp != nil &&
+			(resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable) &&
+			resp.Header.Get("Retry-After") != "" &&
+			slices.Contains(t.policy.RetryableStatusCodes, resp.StatusCode)
+	}
+	if err != nil {
+		// Connection errors such as resets are retryable, but cancellations are not. An attempt that exceeded its own
+		// deadline from the timeout transport is retryable, as long as the deadline of the request hasn't passed.
+		if errors.Is(err, context.DeadlineExceeded) {
+			return req.Context().Err() == nil
+		}
+		return !errors.Is(err, context.Canceled)
+	}
+	return resp != nil && slices.Contains(t.policy.RetryableStatusCodes, resp.StatusCode)
+}
+
+// Requests that have the same effect when repeated
+func isIdempotent(req *http.Request) bool {
+	return isReadRequest(req) || req.Method == http.MethodPut || req.Method == http.MethodDelete
+}
+
+// Use the Retry-After header when the server provides one in seconds, otherwise use the current backoff
+func retryAfter(resp *http.Response, backOffTime time.Duration) time.Duration {
+	if resp == nil {
+		return backOffTime
+	}
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return backOffTime
+	}
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,145 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/api"
+)
+
+func TestRetryTransport(t *testing.T) {
+	testCases := []struct {
+		name string
+		// Method of the request
+		method string
+		// Status code of the first response, or 0 to close the connection without a response
+		firstStatus int
+		// Retry-After header of the first response
+		retryAfter string
+		// Status codes retried by the policy, or the default status codes if nil
+		retryableStatusCodes []int
+		expectedAttempts     int32
+	}{
+		{name: "GET retried on 500", method: http.MethodGet, firstStatus: http.StatusInternalServerError, expectedAttempts: 2},
+		{name: "GET retried on connection error", method: http.MethodGet, expectedAttempts: 2},
+		{name: "GET not retried on 400", method: http.MethodGet, firstStatus: http.StatusBadRequest, expectedAttempts: 1},
+		{name: "PUT retried on 502", method: http.MethodPut, firstStatus: http.StatusBadGateway, expectedAttempts: 2},
+		{name: "DELETE retried on connection error", method: http.MethodDelete, expectedAttempts: 2},
+		{name: "POST not retried on 500", method: http.MethodPost, firstStatus: http.StatusInternalServerError, expectedAttempts: 1},
+		{name: "POST not retried on connection error", method: http.MethodPost, expectedAttempts: 1},
+		{name: "POST not retried on 503 without Retry-After", method: http.MethodPost, firstStatus: http.StatusServiceUnavailable, expectedAttempts: 1},
+		{name: "POST retried on 503 with Retry-After", method: http.MethodPost, firstStatus: http.StatusServiceUnavailable, retryAfter: "0", expectedAttempts: 2},
+		{name: "POST retried on 429 with Retry-After", method: http.MethodPost, firstStatus: http.StatusTooManyRequests, retryAfter: "0", expectedAttempts: 2},
+		{name: "POST not retried on 429 when not retryable", method: http.MethodPost, firstStatus: http.StatusTooManyRequests, retryAfter: "0", retryableStatusCodes: []int{http.StatusServiceUnavailable}, expectedAttempts: 1},
+		{name: "PATCH not retried on 504", method: http.MethodPatch, firstStatus: http.StatusGatewayTimeout, expectedAttempts: 1},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if attempts.Add(1) > 1 {
+					w.WriteHeader(http.StatusOK)
+					return
+				}
+				if testCase.firstStatus == 0 {
+					conn, _, err := w.(http.Hijacker).Hijack()
+					if err != nil {
+						t.Errorf("Failed to hijack connection: %v", err)
+						return
+					}
+					conn.Close()
+					return
+				}
+				if testCase.retryAfter != "" {
+					w.Header().Set("Retry-After", testCase.retryAfter)
+				}
+				w.WriteHeader(testCase.firstStatus)
+			}))
+			defer server.Close()
+
+			policy := api.DefaultRetryPolicy()
+			policy.MinBackoff = time.Millisecond
+			policy.MaxBackoff = time.Millisecond
+			if testCase.retryableStatusCodes != nil {
+				policy.RetryableStatusCodes = testCase.retryableStatusCodes
+			}
+			// Disable keep-alives, so closed connections aren't retried by the base transport itself
+			client := &http.Client{Transport: api.NewRetryTransport(&http.Transport{DisableKeepAlives: true}, policy)}
+
+			req, err := http.NewRequest(testCase.method, server.URL, strings.NewReader("{}"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := client.Do(req)
+			if err == nil {
+				resp.Body.Close()
+			}
+			if attempts.Load() != testCase.expectedAttempts {
+				t.Errorf("expected %d attempts, got %d", testCase.expectedAttempts, attempts.Load())
+			}
+		})
+	}
+}
+
+func TestRetryTransportAttemptTimeout(t *testing.T) {
+	testCases := []struct {
+		name string
+		// Timeout of each attempt
+		attemptTimeout time.Duration
+		// Timeout of the request, including all attempts
+		requestTimeout   time.Duration
+		expectedAttempts int32
+		expectError      bool
+	}{
+		{name: "attempt timeout retried", attemptTimeout: 50 * time.Millisecond, requestTimeout: 5 * time.Second, expectedAttempts: 2},
+		{name: "request timeout not retried", attemptTimeout: 5 * time.Second, requestTimeout: 50 * time.Millisecond, expectedAttempts: 1, expectError: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if attempts.Add(1) == 1 {
+					// Hang until the client gives up on the first attempt
+					select {
+					case <-r.Context().Done():
+					case <-time.After(10 * time.Second):
+					}
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			policy := api.DefaultRetryPolicy()
+			policy.MinBackoff = time.Millisecond
+			policy.MaxBackoff = time.Millisecond
+			client := &http.Client{Transport: api.NewRetryTransport(api.NewTimeoutTransport(&http.Transport{DisableKeepAlives: true}, testCase.attemptTimeout), policy)}
+
+			ctx, cancel := context.WithTimeout(context.Background(), testCase.requestTimeout)
+			defer cancel()
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := client.Do(req)
+			if err == nil {
+				resp.Body.Close()
+			}
+			if (err != nil) != testCase.expectError {
+				t.Errorf("expected error %t, got %v", testCase.expectError, err)
+			}
+			if attempts.Load() != testCase.expectedAttempts {
+				t.Errorf("expected %d attempts, got %d", testCase.expectedAttempts, attempts.Load())
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+type timeoutTransport struct {
+	base    http.RoundTripper
+	timeout time.Duration
+}
+
+// NewTimeoutTransport wraps an http.RoundTripper so that each request, including reading its response body,
+// must complete within the given timeout. Unlike http.Client.Timeout, the timeout applies to each attempt
+// separately when wrapped by the retry transport. A timeout of zero means no timeout.
+func NewTimeoutTransport(base http.RoundTripper, timeout time.Duration) http.RoundTripper {
+	if timeout <= 0 {
+		return base
+	}
+	return &timeoutTransport{
+		base:    base,
+		timeout: timeout,
+	}
+}
+
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil || resp.Body == nil {
+		cancel()
+		return resp, err
+	}
+	// Keep the deadline active until the response body has been read and closed
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
@@ -0,0 +1,182 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// tflog subsystem used for admin API request tracing
+	TraceSubsystem = "http"
+	// Environment variable used to enable and set the level of the trace subsystem
+	TraceLogLevelEnvVar = "TF_LOG_PROVIDER_PINGFEDERATE_HTTP"
+
+	redactedValue = "***REDACTED***"
+)
+
+// Keys whose values are redacted from traced request and response bodies. Any key containing "password" or
+// "secret" is also redacted, as are the values of plugin configuration fields with sensitive names.
+var sensitiveKeys = map[string]bool{
+	"accesstoken":      true,
+	"clientassertion":  true,
+	"credential":       true,
+	"encryptedkeydata": true,
+	"encryptedvalue":   true,
+	"filedata":         true,
+	"privatekey":       true,
+	"refreshtoken":     true,
+}
+
+// Words that identify sensitive plugin configuration fields, in addition to the sensitive keys. Field names are
+// compared in lower case without spaces.
+var sensitiveFieldNameWords = []string{
+	"apikey",
+	"credential",
+	"passphrase",
+	"privatekey",
+	"token",
+}
+
+type traceTransport struct {
+	base http.RoundTripper
+}
+
+// NewTraceTransport wraps an http.RoundTripper so that the method, URL, status, timing, and bodies of each
+// request are logged to the "http" tflog subsystem. Tracing is opt-in via the TF_LOG_PROVIDER_PINGFEDERATE_HTTP
+// environment variable, and values under sensitive keys are redacted from the logged bodies.
+func NewTraceTransport(base http.RoundTripper) http.RoundTripper {
+	return &traceTransport{
+		base: base,
+	}
+}
+
+func (t *traceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if os.Getenv(TraceLogLevelEnvVar) == "" {
+		return t.base.RoundTrip(req)
+	}
+
+	ctx := tflog.NewSubsystem(req.Context(), TraceSubsystem, tflog.WithLevelFromEnv(TraceLogLevelEnvVar))
+	requestFields := map[string]interface{}{
+		"method": req.Method,
+		"url":    req.URL.Redacted(),
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err == nil {
+			requestBody, _ := io.ReadAll(body)
+			body.Close()
+			requestFields["request_body"] = RedactBody(requestBody)
+		}
+	}
+	tflog.SubsystemDebug(ctx, TraceSubsystem, "Sending PingFederate admin API request", requestFields)
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	responseFields := map[string]interface{}{
+		"method":      req.Method,
+		"url":         req.URL.Redacted(),
+		"duration_ms": time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		responseFields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, TraceSubsystem, "PingFederate admin API request failed", responseFields)
+		return resp, err
+	}
+
+	responseFields["status"] = resp.StatusCode
+	if resp.Body != nil {
+		responseBody, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		// Replace the consumed body so the caller can still read it
+		resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+		if readErr != nil {
+			responseFields["error"] = readErr.Error()
+		} else {
+			responseFields["response_body"] = RedactBody(responseBody)
+		}
+	}
+	tflog.SubsystemDebug(ctx, TraceSubsystem, "Received PingFederate admin API response", responseFields)
+	return resp, err
+}
+
+// RedactBody returns a JSON body with the values of sensitive keys redacted. Bodies that are not JSON are
+// omitted entirely, since they can't be redacted reliably.
+func RedactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var parsed interface{}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return "<non-JSON body omitted>"
+	}
+	redacted, err := json.Marshal(redact(parsed))
+	if err != nil {
+		return "<body omitted>"
+	}
+	return string(redacted)
+}
+
+func redact(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		sensitiveField := isSensitiveField(v)
+		for key, nested := range v {
+			if nested != nil && (isSensitiveKey(key) || (sensitiveField && strings.EqualFold(key, "value"))) {
+				v[key] = redactedValue
+			} else {
+				v[key] = redact(nested)
+			}
+		}
+		return v
+	case []interface{}:
+		for i, nested := range v {
+			v[i] = redact(nested)
+		}
+		return v
+	}
+	return value
+}
+
+// Check if an object is a plugin configuration field with a sensitive name. PingFederate doesn't distinguish
+// sensitive fields in requests, so the values of fields such as {"name": "Client Secret", "value": "..."} are only
+// identified by their names.
+func isSensitiveField(object map[string]interface{}) bool {
+	name, ok := object["name"].(string)
+	if !ok {
+		return false
+	}
+	if _, ok := object["value"]; !ok {
+		return false
+	}
+	normalizedName := strings.NewReplacer(" ", "", "-", "", "_", "", ".", "").Replace(strings.ToLower(name))
+	if isSensitiveKey(normalizedName) {
+		return true
+	}
+	for _, word := range sensitiveFieldNameWords {
+		if strings.Contains(normalizedName, word) {
+			return true
+		}
+	}
+	return false
+}
+
+func isSensitiveKey(key string) bool {
+	lowerKey := strings.ToLower(key)
+	// References to other objects only contain their IDs
+	if strings.HasSuffix(lowerKey, "ref") || strings.HasSuffix(lowerKey, "refs") {
+		return false
+	}
+	if sensitiveKeys[lowerKey] {
+		return true
+	}
+	return strings.Contains(lowerKey, "password") || strings.Contains(lowerKey, "secret")
+}
@@ -0,0 +1,62 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package api_test
+
+import (
+	"testing"
+
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/api"
+)
+
+func TestRedactBody(t *testing.T) {
+	testCases := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			name:     "empty body",
+			body:     "",
+			expected: "",
+		},
+		{
+			name:     "non-JSON body",
+			body:     "<html></html>",
+			expected: "<non-JSON body omitted>",
+		},
+		{
+			name:     "sensitive keys",
+			body:     `{"id":"client1","clientAuth":{"type":"SECRET","secret":"s3cret","encryptedSecret":"abc"},"accessToken":"token"}`,
+			expected: `{"accessToken":"***REDACTED***","clientAuth":{"encryptedSecret":"***REDACTED***","secret":"***REDACTED***","type":"SECRET"},"id":"client1"}`,
+		},
+		{
+			name:     "non-string values under sensitive keys",
+			body:     `{"password":12345,"secondarySecrets":[{"secret":"old","expiryTime":"2026-01-01T00:00:00Z"}],"clientSecret":{"value":"nested"},"passwordChangeRequired":null}`,
+			expected: `{"clientSecret":"***REDACTED***","password":"***REDACTED***","passwordChangeRequired":null,"secondarySecrets":"***REDACTED***"}`,
+		},
+		{
+			name:     "references are not redacted",
+			body:     `{"passwordCredentialValidatorRef":{"id":"pcv1"}}`,
+			expected: `{"passwordCredentialValidatorRef":{"id":"pcv1"}}`,
+		},
+		{
+			name:     "plugin configuration fields",
+			body:     `{"configuration":{"fields":[{"name":"Client Secret","value":"plaintext"},{"name":"Client ID","value":"client1"},{"name":"API Key","value":"key"},{"name":"Bind Password","value":"pw","encryptedValue":"enc"}]}}`,
+			expected: `{"configuration":{"fields":[{"name":"Client Secret","value":"***REDACTED***"},{"name":"Client ID","value":"client1"},{"name":"API Key","value":"***REDACTED***"},{"encryptedValue":"***REDACTED***","name":"Bind Password","value":"***REDACTED***"}]}}`,
+		},
+		{
+			name:     "plugin configuration table fields",
+			body:     `{"configuration":{"tables":[{"name":"Users","rows":[{"fields":[{"name":"Username","value":"joe"},{"name":"Password","value":"pw"}]}]}]}}`,
+			expected: `{"configuration":{"tables":[{"name":"Users","rows":[{"fields":[{"name":"Username","value":"joe"},{"name":"Password","value":"***REDACTED***"}]}]}]}}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			redacted := api.RedactBody([]byte(testCase.body))
+			if redacted != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, redacted)
+			}
+		})
+	}
+}
@@ -33,6 +33,7 @@ func ToSchemaWithSuffix(required, computed, fullyComputed bool, descriptionSuffi
 		},
 		Validators: []validator.Map{
 			configvalidators.ValidAttributeContractFulfillment(),
+			ValidAttributeSourceReferences(),
 		},
 	}
 }
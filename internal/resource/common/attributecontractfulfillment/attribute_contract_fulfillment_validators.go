@@ -0,0 +1,234 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package attributecontractfulfillment
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+)
+
+// The attribute_sources attribute for each data store source type
+var dataStoreSourceTypeAttributes = map[string]string{
+	"CUSTOM_DATA_STORE": "custom_attribute_source",
+	"JDBC_DATA_STORE":   "jdbc_attribute_source",
+	"LDAP_DATA_STORE":   "ldap_attribute_source",
+}
+
+// Get the source type, source id and value of a fulfillment entry. The returned values are null if the entry
+// can't be read.
+func fulfillmentValues(fulfillment attr.Value) (sourceType, sourceId, value types.String) {
+	sourceType, sourceId, value = types.StringNull(), types.StringNull(), types.StringNull()
+	fulfillmentObj, ok := fulfillment.(types.Object)
+	if !ok || fulfillmentObj.IsNull() || fulfillmentObj.IsUnknown() {
+		return
+	}
+	if fulfillmentValue, ok := fulfillmentObj.Attributes()["value"].(types.String); ok {
+		value = fulfillmentValue
+	}
+	source, ok := fulfillmentObj.Attributes()["source"].(types.Object)
+	if !ok || source.IsNull() || source.IsUnknown() {
+		return
+	}
+	if sourceTypeValue, ok := source.Attributes()["type"].(types.String); ok {
+		sourceType = sourceTypeValue
+	}
+	if sourceIdValue, ok := source.Attributes()["id"].(types.String); ok {
+		sourceId = sourceIdValue
+	}
+	return
+}
+
+var _ validator.Map = &attributeSourceReferencesValidator{}
+
+type attributeSourceReferencesValidator struct{}
+
+func (v attributeSourceReferencesValidator) Description(ctx context.Context) string {
+	return "Validates that any data store source `id` defined in the attribute contract fulfillment refers to an attribute source of the same type in the sibling `attribute_sources`."
+}
+
+func (v attributeSourceReferencesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v attributeSourceReferencesValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	// If the value is unknown or null, there is nothing to validate.
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	// Not every attribute contract fulfillment has attribute sources alongside it
+	attributeSourcesPath := req.Path.ParentPath().AtName("attribute_sources")
+	var attributeSources types.List
+	diags := req.Config.GetAttribute(ctx, attributeSourcesPath, &attributeSources)
+	if diags.HasError() || attributeSources.IsUnknown() {
+		return
+	}
+
+	// Map each attribute source id to its source type
+	attributeSourceTypes := map[string]string{}
+	for _, attributeSource := range attributeSources.Elements() {
+		attributeSourceObj, ok := attributeSource.(types.Object)
+		if !ok || attributeSourceObj.IsUnknown() {
+			return
+		}
+		for sourceType, attrName := range dataStoreSourceTypeAttributes {
+			source, ok := attributeSourceObj.Attributes()[attrName].(types.Object)
+			if !ok || source.IsNull() {
+				continue
+			}
+			if source.IsUnknown() {
+				return
+			}
+			id, ok := source.Attributes()["id"].(types.String)
+			if !ok {
+				// The attribute sources of this resource have no ids, so the source ids are ignored
+				return
+			}
+			if id.IsUnknown() {
+				return
+			}
+			if !id.IsNull() {
+				attributeSourceTypes[id.ValueString()] = sourceType
+			}
+		}
+	}
+	// When attribute sources are defined without ids, PingFederate doesn't use the source ids to find them
+	if len(attributeSources.Elements()) > 0 && len(attributeSourceTypes) == 0 {
+		return
+	}
+
+	for key, fulfillment := range req.ConfigValue.Elements() {
+		sourceType, sourceId, _ := fulfillmentValues(fulfillment)
+		if sourceType.IsUnknown() || sourceId.IsUnknown() || sourceId.ValueString() == "" {
+			continue
+		}
+		if _, ok := dataStoreSourceTypeAttributes[sourceType.ValueString()]; !ok {
+			continue
+		}
+		idPath := req.Path.AtMapKey(key).AtName("source").AtName("id")
+		attributeSourceType, ok := attributeSourceTypes[sourceId.ValueString()]
+		if !ok {
+			definedIds := slices.Sorted(maps.Keys(attributeSourceTypes))
+			resp.Diagnostics.AddAttributeError(
+				idPath,
+				providererror.InvalidAttributeConfiguration,
+				fmt.Sprintf("attribute_contract_fulfillment key '%s' refers to attribute source '%s', which is not defined in %s. Defined attribute source ids: [%s]",
+					key, sourceId.ValueString(), attributeSourcesPath, strings.Join(definedIds, ", ")),
+			)
+		} else if attributeSourceType != sourceType.ValueString() {
+			resp.Diagnostics.AddAttributeError(
+				idPath,
+				providererror.InvalidAttributeConfiguration,
+				fmt.Sprintf("attribute_contract_fulfillment key '%s' has a source type of '%s', but attribute source '%s' in %s is a %s attribute source",
+					key, sourceType.ValueString(), sourceId.ValueString(), attributeSourcesPath, dataStoreSourceTypeAttributes[attributeSourceType]),
+			)
+		}
+	}
+}
+
+// ValidAttributeSourceReferences validates that the JDBC, LDAP and custom data store sources in an attribute contract
+// fulfillment refer to an attribute source in the sibling attribute_sources attribute, when there is one.
+func ValidAttributeSourceReferences() attributeSourceReferencesValidator {
+	return attributeSourceReferencesValidator{}
+}
+
+var _ validator.Map = &contractReferencesValidator{}
+
+type contractReferencesValidator struct {
+	sourceType         string
+	contractAttributes []path.Expression
+}
+
+func (v contractReferencesValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("Validates that the `value` of any `%s` source defined in the attribute contract fulfillment is an attribute in the contract at %v.", v.sourceType, v.contractAttributes)
+}
+
+func (v contractReferencesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v contractReferencesValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	// If the value is unknown or null, there is nothing to validate.
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	// Collect the names of the attributes in the contract
+	contractNames := map[string]bool{}
+	contractDefined := false
+	for _, expression := range v.contractAttributes {
+		matchedPaths, diags := req.Config.PathMatches(ctx, req.PathExpression.Merge(expression))
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+		for _, matchedPath := range matchedPaths {
+			// If a parent of the contract attributes is null or unknown, the parent path is matched
+			var contractValue attr.Value
+			diags := req.Config.GetAttribute(ctx, matchedPath, &contractValue)
+			resp.Diagnostics.Append(diags...)
+			if diags.HasError() || contractValue.IsUnknown() {
+				return
+			}
+			if contractValue.IsNull() {
+				continue
+			}
+			contractAttributes, ok := contractValue.(types.Set)
+			if !ok {
+				return
+			}
+			contractDefined = true
+			for _, contractAttribute := range contractAttributes.Elements() {
+				contractAttributeObj, ok := contractAttribute.(types.Object)
+				if !ok || contractAttributeObj.IsUnknown() {
+					return
+				}
+				name, ok := contractAttributeObj.Attributes()["name"].(types.String)
+				if !ok || name.IsUnknown() {
+					return
+				}
+				contractNames[name.ValueString()] = true
+			}
+		}
+	}
+	// When the contract is not configured, PingFederate uses a contract that isn't known at plan time
+	if !contractDefined {
+		return
+	}
+
+	for key, fulfillment := range req.ConfigValue.Elements() {
+		sourceType, _, value := fulfillmentValues(fulfillment)
+		if sourceType.ValueString() != v.sourceType || value.IsUnknown() || value.ValueString() == "" {
+			continue
+		}
+		if !contractNames[value.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtMapKey(key).AtName("value"),
+				providererror.InvalidAttributeConfiguration,
+				fmt.Sprintf("attribute_contract_fulfillment key '%s' has a source type of '%s' and refers to attribute '%s', which is not in the contract. Add the attribute to the contract or use an attribute that is in the contract.",
+					key, v.sourceType, value.ValueString()),
+			)
+		}
+	}
+}
+
+// ValidContractReferences validates that the values of the attribute contract fulfillment entries with the given
+// source type are names of attributes in the contract. The contract is defined by the sets of attributes at the
+// given path expressions, relative to the attribute contract fulfillment. The check is only done when the contract is
+// configured in the same resource. Authentication policy contracts are referred to by ID, so references to them are
+// checked when planning with ValidatePolicyContractSources and ValidatePolicyContractKeys instead.
+func ValidContractReferences(sourceType string, contractAttributes ...path.Expression) contractReferencesValidator {
+	return contractReferencesValidator{
+		sourceType:         sourceType,
+		contractAttributes: contractAttributes,
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package attributecontractfulfillment
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+)
+
+// PolicyContractFunc gets the names of the attributes of an authentication policy contract from PingFederate
+type PolicyContractFunc func(ctx context.Context, contractId string) (map[string]bool, *http.Response, error)
+
+// The contract may have attributes added in the same apply, so references to missing attributes are warnings
+const policyContractChangedNote = "This warning can be ignored if the attribute is added to the contract in the same apply."
+
+// ValidatePolicyContractSources warns about attribute contract fulfillment entries with an
+// AUTHENTICATION_POLICY_CONTRACT source whose value is not an attribute of the authentication policy contract with
+// the ID at contractIdPath. The contract is retrieved from PingFederate when planning, so the check is skipped when
+// the contract doesn't exist yet.
+func ValidatePolicyContractSources(ctx context.Context, config tfsdk.Config, fulfillmentPath, contractIdPath path.Path, getContract PolicyContractFunc, diags *diag.Diagnostics) {
+	fulfillment, contractId, contractNames := policyContractFulfillment(ctx, config, fulfillmentPath, contractIdPath, getContract, diags)
+	if contractNames == nil {
+		return
+	}
+	for key, entry := range fulfillment.Elements() {
+		sourceType, _, value := fulfillmentValues(entry)
+		if sourceType.ValueString() != "AUTHENTICATION_POLICY_CONTRACT" || value.IsUnknown() || value.ValueString() == "" {
+			continue
+		}
+		if !contractNames[value.ValueString()] {
+			diags.AddAttributeWarning(
+				fulfillmentPath.AtMapKey(key).AtName("value"),
+				providererror.ContractReferenceWarning,
+				fmt.Sprintf("attribute_contract_fulfillment key '%s' has a source type of 'AUTHENTICATION_POLICY_CONTRACT' and refers to attribute '%s', which is not in authentication policy contract '%s' on the PingFederate server. "+
+					"Add the attribute to the contract or use an attribute that is in the contract. %s", key, value.ValueString(), contractId, policyContractChangedNote),
+			)
+		}
+	}
+}
+
+// ValidatePolicyContractKeys warns about attribute contract fulfillment keys that are not attributes of the
+// authentication policy contract with the ID at contractIdPath, for mappings that fulfill the contract. The contract
+// is retrieved from PingFederate when planning, so the check is skipped when the contract doesn't exist yet.
+func ValidatePolicyContractKeys(ctx context.Context, config tfsdk.Config, fulfillmentPath, contractIdPath path.Path, getContract PolicyContractFunc, diags *diag.Diagnostics) {
+	fulfillment, contractId, contractNames := policyContractFulfillment(ctx, config, fulfillmentPath, contractIdPath, getContract, diags)
+	if contractNames == nil {
+		return
+	}
+	for key := range fulfillment.Elements() {
+		if !contractNames[key] {
+			diags.AddAttributeWarning(
+				fulfillmentPath.AtMapKey(key),
+				providererror.ContractReferenceWarning,
+				fmt.Sprintf("attribute_contract_fulfillment key '%s' is not an attribute of authentication policy contract '%s' on the PingFederate server. "+
+					"Add the attribute to the contract or remove the key. %s", key, contractId, policyContractChangedNote),
+			)
+		}
+	}
+}
+
+// Get the attribute contract fulfillment and the attribute names of the referenced authentication policy contract.
+// The returned names are nil if the fulfillment or contract ID is not known, or the contract can't be retrieved.
+func policyContractFulfillment(ctx context.Context, config tfsdk.Config, fulfillmentPath, contractIdPath path.Path, getContract PolicyContractFunc, diags *diag.Diagnostics) (types.Map, string, map[string]bool) {
+	var fulfillment types.Map
+	var contractId types.String
+	diags.Append(config.GetAttribute(ctx, fulfillmentPath, &fulfillment)...)
+	diags.Append(config.GetAttribute(ctx, contractIdPath, &contractId)...)
+	if diags.HasError() || fulfillment.IsNull() || fulfillment.IsUnknown() || contractId.IsNull() || contractId.IsUnknown() {
+		return fulfillment, "", nil
+	}
+
+	contractNames, httpResp, err := getContract(ctx, contractId.ValueString())
+	if err != nil {
+		// A contract that doesn't exist yet may be created in the same apply
+		if httpResp == nil || httpResp.StatusCode != http.StatusNotFound {
+			diags.AddAttributeWarning(fulfillmentPath, providererror.PingFederateAPIError,
+				fmt.Sprintf("The attribute contract fulfillment could not be validated, because an error occurred while getting authentication policy contract %s: %s", contractId.ValueString(), err.Error()))
+		}
+		return fulfillment, "", nil
+	}
+	return fulfillment, contractId.ValueString(), contractNames
+}
@@ -0,0 +1,183 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package attributecontractfulfillment
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestValidatePolicyContractReferences(t *testing.T) {
+	ctx := context.Background()
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"source_id":                      schema.StringAttribute{Optional: true},
+			"attribute_contract_fulfillment": ToSchema(true, false, false),
+			"parent": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"source_id":                      schema.StringAttribute{Optional: true},
+					"attribute_contract_fulfillment": ToSchema(false, false, false),
+				},
+			},
+		},
+	}
+	objectType := testSchema.Type().TerraformType(ctx).(tftypes.Object)
+	fulfillmentType := objectType.AttributeTypes["attribute_contract_fulfillment"].(tftypes.Map)
+	entryType := fulfillmentType.ElementType.(tftypes.Object)
+	sourceType := entryType.AttributeTypes["source"].(tftypes.Object)
+	entry := func(sourceTypeValue string, value interface{}) tftypes.Value {
+		sourceAttrs := map[string]tftypes.Value{}
+		for name, attrType := range sourceType.AttributeTypes {
+			sourceAttrs[name] = tftypes.NewValue(attrType, nil)
+		}
+		sourceAttrs["type"] = tftypes.NewValue(tftypes.String, sourceTypeValue)
+		return tftypes.NewValue(entryType, map[string]tftypes.Value{
+			"source": tftypes.NewValue(sourceType, sourceAttrs),
+			"value":  tftypes.NewValue(tftypes.String, value),
+		})
+	}
+	config := func(sourceId interface{}, fulfillment map[string]tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"source_id":                      tftypes.NewValue(tftypes.String, sourceId),
+				"attribute_contract_fulfillment": tftypes.NewValue(fulfillmentType, fulfillment),
+				"parent":                         tftypes.NewValue(objectType.AttributeTypes["parent"], nil),
+			}),
+		}
+	}
+
+	requests := 0
+	getContract := func(ctx context.Context, contractId string) (map[string]bool, *http.Response, error) {
+		requests++
+		switch contractId {
+		case "contract":
+			return map[string]bool{"subject": true, "firstName": true}, &http.Response{StatusCode: http.StatusOK}, nil
+		case "missing":
+			return nil, &http.Response{StatusCode: http.StatusNotFound}, errors.New("404 Not Found")
+		default:
+			return nil, &http.Response{StatusCode: http.StatusInternalServerError}, errors.New("500 Internal Server Error")
+		}
+	}
+
+	fulfillmentPath := path.Root("attribute_contract_fulfillment")
+	testCases := []struct {
+		name             string
+		config           tfsdk.Config
+		validate         func(context.Context, tfsdk.Config, path.Path, path.Path, PolicyContractFunc, *diag.Diagnostics)
+		fulfillmentPath  path.Path
+		contractIdPath   path.Path
+		expectedPaths    []path.Path
+		expectedRequests int
+	}{
+		{
+			name: "sources in the contract",
+			config: config("contract", map[string]tftypes.Value{
+				"userId": entry("AUTHENTICATION_POLICY_CONTRACT", "subject"),
+				"name":   entry("AUTHENTICATION_POLICY_CONTRACT", "firstName"),
+				"static": entry("TEXT", "not a contract attribute"),
+			}),
+			validate:         ValidatePolicyContractSources,
+			expectedRequests: 1,
+		},
+		{
+			name: "source not in the contract",
+			config: config("contract", map[string]tftypes.Value{
+				"userId": entry("AUTHENTICATION_POLICY_CONTRACT", "subject"),
+				"name":   entry("AUTHENTICATION_POLICY_CONTRACT", "fristName"),
+				"unset":  entry("AUTHENTICATION_POLICY_CONTRACT", tftypes.UnknownValue),
+			}),
+			validate:         ValidatePolicyContractSources,
+			expectedPaths:    []path.Path{fulfillmentPath.AtMapKey("name").AtName("value")},
+			expectedRequests: 1,
+		},
+		{
+			name: "keys in the contract",
+			config: config("contract", map[string]tftypes.Value{
+				"subject":   entry("ASSERTION", "SAML_SUBJECT"),
+				"firstName": entry("ASSERTION", "givenName"),
+			}),
+			validate:         ValidatePolicyContractKeys,
+			expectedRequests: 1,
+		},
+		{
+			name: "key not in the contract",
+			config: config("contract", map[string]tftypes.Value{
+				"subject":  entry("ASSERTION", "SAML_SUBJECT"),
+				"lastName": entry("ASSERTION", "sn"),
+			}),
+			validate:         ValidatePolicyContractKeys,
+			expectedPaths:    []path.Path{fulfillmentPath.AtMapKey("lastName")},
+			expectedRequests: 1,
+		},
+		{
+			name: "contract that doesn't exist yet",
+			config: config("missing", map[string]tftypes.Value{
+				"name": entry("AUTHENTICATION_POLICY_CONTRACT", "fristName"),
+			}),
+			validate:         ValidatePolicyContractSources,
+			expectedRequests: 1,
+		},
+		{
+			name: "contract that can't be retrieved",
+			config: config("failing", map[string]tftypes.Value{
+				"name": entry("AUTHENTICATION_POLICY_CONTRACT", "fristName"),
+			}),
+			validate:         ValidatePolicyContractSources,
+			expectedPaths:    []path.Path{fulfillmentPath},
+			expectedRequests: 1,
+		},
+		{
+			name: "unknown contract ID",
+			config: config(tftypes.UnknownValue, map[string]tftypes.Value{
+				"name": entry("AUTHENTICATION_POLICY_CONTRACT", "fristName"),
+			}),
+			validate: ValidatePolicyContractSources,
+		},
+		{
+			name: "null parent",
+			config: config("contract", map[string]tftypes.Value{
+				"name": entry("AUTHENTICATION_POLICY_CONTRACT", "fristName"),
+			}),
+			validate:        ValidatePolicyContractSources,
+			fulfillmentPath: path.Root("parent").AtName("attribute_contract_fulfillment"),
+			contractIdPath:  path.Root("parent").AtName("source_id"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			requests = 0
+			testFulfillmentPath, contractIdPath := fulfillmentPath, path.Root("source_id")
+			if len(testCase.fulfillmentPath.Steps()) > 0 {
+				testFulfillmentPath, contractIdPath = testCase.fulfillmentPath, testCase.contractIdPath
+			}
+			var diags diag.Diagnostics
+			testCase.validate(ctx, testCase.config, testFulfillmentPath, contractIdPath, getContract, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags)
+			}
+			if diags.WarningsCount() != len(testCase.expectedPaths) {
+				t.Fatalf("expected %d warnings, got %v", len(testCase.expectedPaths), diags)
+			}
+			for i, expectedPath := range testCase.expectedPaths {
+				withPath, ok := diags[i].(diag.DiagnosticWithPath)
+				if !ok || !withPath.Path().Equal(expectedPath) {
+					t.Errorf("expected a warning at %s, got %v", expectedPath, diags[i])
+				}
+			}
+			if requests != testCase.expectedRequests {
+				t.Errorf("expected %d requests, got %d", testCase.expectedRequests, requests)
+			}
+		})
+	}
+}
@@ -186,6 +186,9 @@ func toSchemaInternal(sizeAtLeast int, optionalAndComputedNestedAttributeContrac
 	if sizeAtLeast > 0 {
 		validators = append(validators, listvalidator.SizeAtLeast(sizeAtLeast))
 	}
+	if includeIdAttr {
+		validators = append(validators, ValidUniqueIds())
+	}
 	return schema.ListNestedAttribute{
 		Description: "A list of configured data stores to look up attributes from.",
 		Computed:    true,
@@ -0,0 +1,64 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package attributesources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+)
+
+var _ validator.List = &uniqueIdsValidator{}
+
+type uniqueIdsValidator struct{}
+
+func (v uniqueIdsValidator) Description(ctx context.Context) string {
+	return "Validates that each attribute source `id` is unique, so that attribute contract fulfillment sources refer to a single attribute source."
+}
+
+func (v uniqueIdsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v uniqueIdsValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	// If the value is unknown or null, there is nothing to validate.
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	// The index of the attribute source that each id was first found in
+	idIndexes := map[string]int{}
+	for i, attributeSource := range req.ConfigValue.Elements() {
+		attributeSourceObj, ok := attributeSource.(types.Object)
+		if !ok || attributeSourceObj.IsNull() || attributeSourceObj.IsUnknown() {
+			continue
+		}
+		for _, attrName := range []string{"custom_attribute_source", "jdbc_attribute_source", "ldap_attribute_source"} {
+			source, ok := attributeSourceObj.Attributes()[attrName].(types.Object)
+			if !ok || source.IsNull() || source.IsUnknown() {
+				continue
+			}
+			id, ok := source.Attributes()["id"].(types.String)
+			if !ok || id.IsNull() || id.IsUnknown() {
+				continue
+			}
+			if firstIndex, ok := idIndexes[id.ValueString()]; ok {
+				resp.Diagnostics.AddAttributeError(
+					req.Path.AtListIndex(i).AtName(attrName).AtName("id"),
+					providererror.InvalidAttributeConfiguration,
+					fmt.Sprintf("Attribute source id '%s' is already used by the attribute source at %s. Each attribute source must have a unique id.", id.ValueString(), req.Path.AtListIndex(firstIndex)),
+				)
+				continue
+			}
+			idIndexes[id.ValueString()] = i
+		}
+	}
+}
+
+// ValidUniqueIds validates that the attribute sources in the list have unique ids
+func ValidUniqueIds() uniqueIdsValidator {
+	return uniqueIdsValidator{}
+}
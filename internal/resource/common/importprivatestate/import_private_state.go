@@ -17,9 +17,20 @@ type importPrivateState struct {
 
 const importKey = "import"
 
+// PrivateStateSetter is the private state of a resource response
+type PrivateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
 func MarkPrivateStateForImport(ctx context.Context, resp *resource.ImportStateResponse) diag.Diagnostics {
+	return MarkPrivateState(ctx, resp.Private)
+}
+
+// MarkPrivateState marks the given private state so that the next read is handled as the read following an import,
+// for example when a resource adopts an existing object on create
+func MarkPrivateState(ctx context.Context, private PrivateStateSetter) diag.Diagnostics {
 	value := []byte(`{"isImport": true}`)
-	return resp.Private.SetKey(ctx, importKey, value)
+	return private.SetKey(ctx, importKey, value)
 }
 
 func IsImportRead(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) (bool, diag.Diagnostics) {
@@ -0,0 +1,69 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package movestate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+)
+
+// Source address of the provider, which is the end of the address of any installation of the provider
+const providerSource = "pingidentity/pingfederate"
+
+// FromRemovedResource creates a state mover that moves the state of a resource type that has been removed from the
+// provider to the resource type that replaced it, so that a moved block can be used instead of removing the old
+// resource from state and importing the new one. Attributes with the same name in both resource types are copied,
+// and renamedAttributes maps attribute names of the removed resource type to the names used by the replacement.
+// Attributes that are not copied are null until the resource is next read from PingFederate.
+func FromRemovedResource(removedTypeName string, renamedAttributes map[string]string) resource.StateMover {
+	return resource.StateMover{
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			if req.SourceTypeName != removedTypeName || !strings.HasSuffix(req.SourceProviderAddress, "/"+providerSource) {
+				return
+			}
+			if req.SourceRawState == nil {
+				resp.Diagnostics.AddError(providererror.InternalProviderError, fmt.Sprintf("Unable to move %s, because the source state is empty", removedTypeName))
+				return
+			}
+
+			var attrs map[string]json.RawMessage
+			if err := json.Unmarshal(req.SourceRawState.JSON, &attrs); err != nil {
+				resp.Diagnostics.AddError(providererror.InternalProviderError, fmt.Sprintf("Unable to read the state of %s: %s", removedTypeName, err.Error()))
+				return
+			}
+			for oldName, newName := range renamedAttributes {
+				if _, ok := attrs[newName]; ok {
+					continue
+				}
+				if value, ok := attrs[oldName]; ok {
+					attrs[newName] = value
+				}
+			}
+			stateJson, err := json.Marshal(attrs)
+			if err != nil {
+				resp.Diagnostics.AddError(providererror.InternalProviderError, fmt.Sprintf("Unable to move the state of %s: %s", removedTypeName, err.Error()))
+				return
+			}
+
+			// Attributes that only exist in the removed resource type are dropped
+			rawState := tfprotov6.RawState{JSON: stateJson}
+			targetState, err := rawState.UnmarshalWithOpts(resp.TargetState.Schema.Type().TerraformType(ctx), tfprotov6.UnmarshalOpts{
+				ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+					IgnoreUndefinedAttributes: true,
+				},
+			})
+			if err != nil {
+				resp.Diagnostics.AddError(providererror.InternalProviderError, fmt.Sprintf("Unable to move the state of %s: %s", removedTypeName, err.Error()))
+				return
+			}
+			resp.TargetState.Raw = targetState
+		},
+	}
+}
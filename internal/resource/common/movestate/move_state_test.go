@@ -0,0 +1,120 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package movestate_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/movestate"
+)
+
+func TestFromRemovedResource(t *testing.T) {
+	ctx := context.Background()
+	targetSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":         schema.StringAttribute{Computed: true},
+			"key_id":     schema.StringAttribute{Required: true},
+			"file_data":  schema.StringAttribute{Optional: true},
+			"expires":    schema.StringAttribute{Computed: true},
+			"new_option": schema.BoolAttribute{Optional: true},
+		},
+	}
+	objectType := targetSchema.Type().TerraformType(ctx)
+	mover := movestate.FromRemovedResource("pingfederate_old_resource", map[string]string{
+		"import_id": "key_id",
+	})
+
+	testCases := []struct {
+		name           string
+		sourceTypeName string
+		sourceProvider string
+		sourceState    string
+		expectMoved    bool
+		expectError    bool
+		expectedTarget tftypes.Value
+	}{
+		{
+			name:           "renamed and removed attributes",
+			sourceTypeName: "pingfederate_old_resource",
+			sourceProvider: "registry.terraform.io/pingidentity/pingfederate",
+			sourceState:    `{"id":"key1","import_id":"key1","file_data":"abc","expires":"2030-01-01T00:00:00Z","removed_option":true}`,
+			expectMoved:    true,
+			expectedTarget: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"id":         tftypes.NewValue(tftypes.String, "key1"),
+				"key_id":     tftypes.NewValue(tftypes.String, "key1"),
+				"file_data":  tftypes.NewValue(tftypes.String, "abc"),
+				"expires":    tftypes.NewValue(tftypes.String, "2030-01-01T00:00:00Z"),
+				"new_option": tftypes.NewValue(tftypes.Bool, nil),
+			}),
+		},
+		{
+			name:           "attribute already using the new name",
+			sourceTypeName: "pingfederate_old_resource",
+			sourceProvider: "registry.terraform.io/pingidentity/pingfederate",
+			sourceState:    `{"id":"key1","import_id":"old","key_id":"new"}`,
+			expectMoved:    true,
+			expectedTarget: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"id":         tftypes.NewValue(tftypes.String, "key1"),
+				"key_id":     tftypes.NewValue(tftypes.String, "new"),
+				"file_data":  tftypes.NewValue(tftypes.String, nil),
+				"expires":    tftypes.NewValue(tftypes.String, nil),
+				"new_option": tftypes.NewValue(tftypes.Bool, nil),
+			}),
+		},
+		{
+			name:           "wrong source type name",
+			sourceTypeName: "pingfederate_other_resource",
+			sourceProvider: "registry.terraform.io/pingidentity/pingfederate",
+			sourceState:    `{"id":"key1","import_id":"key1"}`,
+		},
+		{
+			name:           "wrong source provider",
+			sourceTypeName: "pingfederate_old_resource",
+			sourceProvider: "registry.terraform.io/hashicorp/random",
+			sourceState:    `{"id":"key1","import_id":"key1"}`,
+		},
+		{
+			name:           "invalid source state",
+			sourceTypeName: "pingfederate_old_resource",
+			sourceProvider: "registry.terraform.io/pingidentity/pingfederate",
+			sourceState:    `{"id":"key1","new_option":"not a bool","file_data":["abc"]}`,
+			expectError:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			req := resource.MoveStateRequest{
+				SourceProviderAddress: testCase.sourceProvider,
+				SourceTypeName:        testCase.sourceTypeName,
+				SourceRawState:        &tfprotov6.RawState{JSON: []byte(testCase.sourceState)},
+			}
+			resp := resource.MoveStateResponse{
+				TargetState: tfsdk.State{
+					Schema: targetSchema,
+					Raw:    tftypes.NewValue(objectType, nil),
+				},
+			}
+			mover.StateMover(ctx, req, &resp)
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Fatalf("expected error %t, got diagnostics %v", testCase.expectError, resp.Diagnostics)
+			}
+			if !testCase.expectMoved {
+				if !resp.TargetState.Raw.IsNull() {
+					t.Errorf("expected the state not to be moved, got %s", resp.TargetState.Raw)
+				}
+				return
+			}
+			if !resp.TargetState.Raw.Equal(testCase.expectedTarget) {
+				t.Errorf("expected %s, got %s", testCase.expectedTarget, resp.TargetState.Raw)
+			}
+		})
+	}
+}
@@ -5,6 +5,8 @@ package pluginconfiguration
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -38,6 +40,11 @@ func (v configurationDuplicateFieldsValidator) ValidateObject(ctx context.Contex
 		checkDuplicateFields(fields, sensitiveFields, "", -1, req, resp)
 	}
 
+	// Check field_values, sensitive_field_values and sensitive_fields_wo against fields, sensitive_fields and each other
+	if fieldsOk && sensitiveFieldsOk {
+		checkDuplicateMapFields(req.ConfigValue.Attributes(), "", -1, req, resp)
+	}
+
 	// Check tables.rows.fields and tables.rows.sensitive_fields
 	tables, tablesOk := req.ConfigValue.Attributes()["tables"]
 	if tablesOk {
@@ -59,6 +66,7 @@ func (v configurationDuplicateFieldsValidator) ValidateObject(ctx context.Contex
 									sensitiveFields, sensitiveFieldsOk := rowObj.Attributes()["sensitive_fields"]
 									if fieldsOk && sensitiveFieldsOk {
 										checkDuplicateFields(fields, sensitiveFields, tableNameStr.ValueString(), rowIndex, req, resp)
+										checkDuplicateMapFields(rowObj.Attributes(), tableNameStr.ValueString(), rowIndex, req, resp)
 									}
 								}
 							}
@@ -112,6 +120,53 @@ func checkDuplicateFields(fields attr.Value, sensitiveFields attr.Value, tableNa
 	}
 }
 
+// The map attributes that set configuration fields by name, in the order they are checked for duplicates
+var fieldMapAttributes = []string{"field_values", "sensitive_field_values", "sensitive_fields_wo"}
+
+func checkDuplicateMapFields(attrs map[string]attr.Value, tableName string, rowIndex int, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	errorMsgLocation := ""
+	if rowIndex != -1 {
+		errorMsgLocation = fmt.Sprintf(" in table '%s' at row with index %d", tableName, rowIndex)
+	}
+	// The attribute that each field name was first found in
+	fieldNames := map[string]string{}
+	for _, fieldsValue := range []attr.Value{attrs["fields"], attrs["sensitive_fields"]} {
+		fieldsObj, fieldsOk := fieldsValue.(types.Set)
+		if !fieldsOk {
+			continue
+		}
+		for _, field := range fieldsObj.Elements() {
+			fieldObj, fieldOk := field.(types.Object)
+			if fieldOk {
+				fieldName, nameOk := fieldObj.Attributes()["name"]
+				if nameOk {
+					nameValue, nameOk := fieldName.(types.String)
+					if nameOk && !nameValue.IsUnknown() {
+						fieldNames[nameValue.ValueString()] = "'fields' or 'sensitive_fields'"
+					}
+				}
+			}
+		}
+	}
+	for _, attributeName := range fieldMapAttributes {
+		fieldsMap, ok := attrs[attributeName].(types.Map)
+		if !ok || fieldsMap.IsUnknown() || fieldsMap.IsNull() {
+			continue
+		}
+		for _, name := range slices.Sorted(maps.Keys(fieldsMap.Elements())) {
+			if otherAttribute, ok := fieldNames[name]; ok {
+				resp.Diagnostics.AddAttributeError(
+					req.Path,
+					providererror.InvalidAttributeConfiguration,
+					fmt.Sprintf("Duplicate field name in '%s' and %s%s: %s", attributeName, otherAttribute, errorMsgLocation, name),
+				)
+				continue
+			}
+			fieldNames[name] = "'" + attributeName + "'"
+		}
+	}
+}
+
 func noDuplicateFields() configurationDuplicateFieldsValidator {
 	return configurationDuplicateFieldsValidator{}
 }
@@ -3,6 +3,9 @@
 package pluginconfiguration
 
 import (
+	"maps"
+	"slices"
+
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
 )
@@ -11,6 +14,10 @@ func ClientStruct(configurationObj types.Object) *client.PluginConfiguration {
 	configurationValue := client.PluginConfiguration{}
 	configurationAttrs := configurationObj.Attributes()
 	configurationValue.Fields = fieldsFromObject(configurationAttrs["fields"].(types.Set), configurationAttrs["sensitive_fields"].(types.Set))
+	// Write-only values are only available when the plan has been populated from the config
+	for _, attrName := range []string{"field_values", "sensitive_field_values", "sensitive_fields_wo"} {
+		configurationValue.Fields = append(configurationValue.Fields, fieldsFromMap(configurationAttrs[attrName].(types.Map))...)
+	}
 	configurationValue.Tables = []client.ConfigTable{}
 	for _, tablesElement := range configurationAttrs["tables"].(types.List).Elements() {
 		tablesValue := client.ConfigTable{}
@@ -22,6 +29,9 @@ func ClientStruct(configurationObj types.Object) *client.PluginConfiguration {
 			rowsAttrs := rowsElement.(types.Object).Attributes()
 			rowsValue.DefaultRow = rowsAttrs["default_row"].(types.Bool).ValueBoolPointer()
 			rowsValue.Fields = fieldsFromObject(rowsAttrs["fields"].(types.Set), rowsAttrs["sensitive_fields"].(types.Set))
+			for _, attrName := range []string{"field_values", "sensitive_field_values"} {
+				rowsValue.Fields = append(rowsValue.Fields, fieldsFromMap(rowsAttrs[attrName].(types.Map))...)
+			}
 			tablesValue.Rows = append(tablesValue.Rows, rowsValue)
 		}
 		configurationValue.Tables = append(configurationValue.Tables, tablesValue)
@@ -48,3 +58,16 @@ func fieldsFromObject(fieldsObj types.Set, sensitiveFieldsObj types.Set) []clien
 	}
 	return fields
 }
+
+// Fields from a map of field names to values, in name order so that requests are consistent
+func fieldsFromMap(fieldsMap types.Map) []client.ConfigField {
+	fields := []client.ConfigField{}
+	fieldValues := fieldsMap.Elements()
+	for _, name := range slices.Sorted(maps.Keys(fieldValues)) {
+		fields = append(fields, client.ConfigField{
+			Name:  name,
+			Value: fieldValues[name].(types.String).ValueStringPointer(),
+		})
+	}
+	return fields
+}
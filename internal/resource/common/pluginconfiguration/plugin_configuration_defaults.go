@@ -0,0 +1,103 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package pluginconfiguration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/plugindescriptor"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+// Whether the value is one that PingFederate uses for the field when it is not included in the configuration
+func isDefaultValue(f plugindescriptor.FieldDescriptor, value string) bool {
+	return (f.DefaultValue != nil && *f.DefaultValue == value) ||
+		(f.DefaultForLegacyConfig != nil && *f.DefaultForLegacyConfig == value)
+}
+
+// ReadDefaultedFields sets fields_defaulted in the configuration state read by ToState to the fields in fields_all
+// that are set to their default value from the descriptor of the plugin type, and that are not included in fields or field_values.
+// When reading for an import, the defaulted fields are also removed from fields, so that they are left out of
+// generated configuration and PingFederate continues to manage their values. If the descriptor can't be retrieved,
+// a warning is added and fields_defaulted keeps the value set by ToState.
+func ReadDefaultedFields(ctx context.Context, configuration types.Object, descriptorId string, isImportRead bool, getDescriptor DescriptorFunc, diags *diag.Diagnostics) types.Object {
+	if !internaltypes.IsDefined(configuration) {
+		return configuration
+	}
+
+	descriptor, _, err := getDescriptor(ctx, descriptorId)
+	if err != nil {
+		diags.AddAttributeWarning(path.Root("configuration"), providererror.ConfigurationWarning,
+			fmt.Sprintf("The fields set to default values could not be determined, because an error occurred while getting plugin descriptor %s: %s", descriptorId, err.Error()))
+		return configuration
+	}
+	descriptorFields := map[string]plugindescriptor.FieldDescriptor{}
+	if descriptor.ConfigDescriptor != nil {
+		for _, field := range descriptor.ConfigDescriptor.Fields {
+			descriptorFields[field.Name] = field
+		}
+	}
+
+	configurationAttrs := configuration.Attributes()
+	fields, ok := configurationAttrs["fields"].(types.Set)
+	if !ok || fields.IsUnknown() {
+		return configuration
+	}
+	fieldsAll, ok := configurationAttrs["fields_all"].(types.Set)
+	if !ok || fieldsAll.IsUnknown() {
+		return configuration
+	}
+
+	// Fields included in fields are managed by the configuration, even when set to the default value
+	configuredFields := map[string]bool{}
+	if !isImportRead {
+		for _, field := range fields.Elements() {
+			configuredFields[field.(types.Object).Attributes()["name"].(types.String).ValueString()] = true
+		}
+		for _, attrName := range []string{"field_values", "sensitive_field_values"} {
+			if fieldsMap, ok := configurationAttrs[attrName].(types.Map); ok {
+				for name := range fieldsMap.Elements() {
+					configuredFields[name] = true
+				}
+			}
+		}
+	}
+
+	defaultedFields := []attr.Value{}
+	defaultedFieldNames := map[string]bool{}
+	for _, field := range fieldsAll.Elements() {
+		fieldAttrs := field.(types.Object).Attributes()
+		name := fieldAttrs["name"].(types.String)
+		value := fieldAttrs["value"].(types.String)
+		descriptorField, ok := descriptorFields[name.ValueString()]
+		if !ok || configuredFields[name.ValueString()] || descriptorField.Sensitive() || value.IsNull() || !isDefaultValue(descriptorField, value.ValueString()) {
+			continue
+		}
+		defaultedFields = append(defaultedFields, field)
+		defaultedFieldNames[name.ValueString()] = true
+	}
+	var respDiags diag.Diagnostics
+	configurationAttrs["fields_defaulted"], respDiags = types.SetValue(types.ObjectType{AttrTypes: fieldAttrTypes}, defaultedFields)
+	diags.Append(respDiags...)
+
+	if isImportRead {
+		importedFields := []attr.Value{}
+		for _, field := range fields.Elements() {
+			if !defaultedFieldNames[field.(types.Object).Attributes()["name"].(types.String).ValueString()] {
+				importedFields = append(importedFields, field)
+			}
+		}
+		configurationAttrs["fields"], respDiags = types.SetValue(types.ObjectType{AttrTypes: fieldAttrTypes}, importedFields)
+		diags.Append(respDiags...)
+	}
+
+	configurationObj, respDiags := types.ObjectValue(configurationAttrTypes, configurationAttrs)
+	diags.Append(respDiags...)
+	return configurationObj
+}
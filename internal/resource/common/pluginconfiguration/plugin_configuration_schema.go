@@ -3,6 +3,8 @@
 package pluginconfiguration
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,6 +20,16 @@ import (
 )
 
 func ToSchema() schema.SingleNestedAttribute {
+	return toSchema(true)
+}
+
+// ToSchemaWithoutWriteOnly returns the configuration schema for data sources, which do not support write-only
+// attributes. The write-only attributes are always null.
+func ToSchemaWithoutWriteOnly() schema.SingleNestedAttribute {
+	return toSchema(false)
+}
+
+func toSchema(writeOnly bool) schema.SingleNestedAttribute {
 	fieldsSetDefault, _ := types.SetValue(types.ObjectType{AttrTypes: fieldAttrTypes}, nil)
 	sensitiveFieldsSetDefault, _ := types.SetValue(types.ObjectType{AttrTypes: sensitiveFieldAttrTypes}, nil)
 	tablesSetDefault, _ := types.ListValue(types.ObjectType{AttrTypes: tablesSensitiveFieldsSplitAttrTypes}, nil)
@@ -79,6 +91,31 @@ func ToSchema() schema.SingleNestedAttribute {
 							NestedObject: sensitiveFieldsNestedObject,
 							Default:      setdefault.StaticValue(sensitiveFieldsSetDefault),
 						},
+						"field_values": schema.MapAttribute{
+							Description: "Map of the names of the configuration fields in the row to values. This is an alternative to `fields` that can be generated directly from a map, such as one decoded from JSON or YAML. Cannot be used with `fields` or `sensitive_fields`.",
+							ElementType: types.StringType,
+							Optional:    true,
+							Computed:    !writeOnly,
+							Validators: []validator.Map{
+								mapvalidator.ConflictsWith(
+									path.MatchRelative().AtParent().AtName("fields"),
+									path.MatchRelative().AtParent().AtName("sensitive_fields"),
+								),
+							},
+						},
+						"sensitive_field_values": schema.MapAttribute{
+							Description: "Map of the names of the sensitive configuration fields in the row to values. This is an alternative to `sensitive_fields` that can be generated directly from a map, such as one decoded from JSON or YAML. Cannot be used with `fields` or `sensitive_fields`.",
+							ElementType: types.StringType,
+							Optional:    true,
+							Computed:    !writeOnly,
+							Sensitive:   true,
+							Validators: []validator.Map{
+								mapvalidator.ConflictsWith(
+									path.MatchRelative().AtParent().AtName("fields"),
+									path.MatchRelative().AtParent().AtName("sensitive_fields"),
+								),
+							},
+						},
 						"default_row": schema.BoolAttribute{
 							Description: "Whether this row is the default.",
 							Computed:    true,
@@ -160,6 +197,31 @@ func ToSchema() schema.SingleNestedAttribute {
 				Default:      setdefault.StaticValue(sensitiveFieldsSetDefault),
 				NestedObject: sensitiveFieldsNestedObject,
 			},
+			"field_values": schema.MapAttribute{
+				Description: "Map of configuration field names to values. This is an alternative to `fields` that can be generated directly from a map, such as one decoded from JSON or YAML. For fields in configuration tables, use the `field_values` attribute of the table row. Cannot be used with `fields` or `sensitive_fields`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    !writeOnly,
+				Validators: []validator.Map{
+					mapvalidator.ConflictsWith(
+						path.MatchRelative().AtParent().AtName("fields"),
+						path.MatchRelative().AtParent().AtName("sensitive_fields"),
+					),
+				},
+			},
+			"sensitive_field_values": schema.MapAttribute{
+				Description: "Map of sensitive configuration field names to values. This is an alternative to `sensitive_fields` that can be generated directly from a map, such as one decoded from JSON or YAML. For fields in configuration tables, use the `sensitive_field_values` attribute of the table row. Cannot be used with `fields` or `sensitive_fields`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    !writeOnly,
+				Sensitive:   true,
+				Validators: []validator.Map{
+					mapvalidator.ConflictsWith(
+						path.MatchRelative().AtParent().AtName("fields"),
+						path.MatchRelative().AtParent().AtName("sensitive_fields"),
+					),
+				},
+			},
 			"fields_all": schema.SetNestedAttribute{
 				Description:  "List of configuration fields. This attribute will include any values set by default by PingFederate.",
 				Computed:     true,
@@ -169,6 +231,31 @@ func ToSchema() schema.SingleNestedAttribute {
 					setplanmodifier.UseNonNullStateForUnknown(),
 				},
 			},
+			"fields_defaulted": schema.SetNestedAttribute{
+				Description:  "List of configuration fields that are not included in `fields` and that PingFederate has set to the default value from the plugin descriptor. Changes to the defaults of a plugin type, such as after a plugin upgrade, are shown in this attribute. Fields in configuration tables are not included. This attribute is not populated for the adapter override settings of connections.",
+				Computed:     true,
+				Optional:     false,
+				NestedObject: fieldsNestedObject,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseNonNullStateForUnknown(),
+				},
+			},
+			"sensitive_fields_wo": schema.MapAttribute{
+				Description: "Map of sensitive configuration field names to values. The values are write-only, so they are sent to PingFederate on each create and update but are never stored in Terraform state. Change `sensitive_fields_wo_version` to send updated values when no other configuration has changed. Fields in configuration tables are not supported. Field names must not also be included in `fields` or `sensitive_fields`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   writeOnly,
+				Computed:    !writeOnly,
+			},
+			"sensitive_fields_wo_version": schema.Int64Attribute{
+				Description: "A version number for the values in `sensitive_fields_wo`. Changing the version triggers an update that sends the current values of `sensitive_fields_wo` to PingFederate.",
+				Optional:    true,
+				Computed:    !writeOnly,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("sensitive_fields_wo")),
+				},
+			},
 		},
 	}
 }
@@ -26,9 +26,11 @@ var (
 	}
 
 	rowsSensitiveFieldsSplitAttrTypes = map[string]attr.Type{
-		"fields":           types.SetType{ElemType: types.ObjectType{AttrTypes: fieldAttrTypes}},
-		"sensitive_fields": types.SetType{ElemType: types.ObjectType{AttrTypes: sensitiveFieldAttrTypes}},
-		"default_row":      types.BoolType,
+		"fields":                 types.SetType{ElemType: types.ObjectType{AttrTypes: fieldAttrTypes}},
+		"sensitive_fields":       types.SetType{ElemType: types.ObjectType{AttrTypes: sensitiveFieldAttrTypes}},
+		"field_values":           types.MapType{ElemType: types.StringType},
+		"sensitive_field_values": types.MapType{ElemType: types.StringType},
+		"default_row":            types.BoolType,
 	}
 	rowsMergedFieldsAttrTypes = map[string]attr.Type{
 		"fields":      types.SetType{ElemType: types.ObjectType{AttrTypes: fieldAttrTypes}},
@@ -45,11 +47,16 @@ var (
 	}
 
 	configurationAttrTypes = map[string]attr.Type{
-		"fields":           types.SetType{ElemType: types.ObjectType{AttrTypes: fieldAttrTypes}},
-		"sensitive_fields": types.SetType{ElemType: types.ObjectType{AttrTypes: sensitiveFieldAttrTypes}},
-		"fields_all":       types.SetType{ElemType: types.ObjectType{AttrTypes: fieldAttrTypes}},
-		"tables":           types.ListType{ElemType: types.ObjectType{AttrTypes: tablesSensitiveFieldsSplitAttrTypes}},
-		"tables_all":       types.ListType{ElemType: types.ObjectType{AttrTypes: tablesMergedFieldsAttrTypes}},
+		"fields":                      types.SetType{ElemType: types.ObjectType{AttrTypes: fieldAttrTypes}},
+		"sensitive_fields":            types.SetType{ElemType: types.ObjectType{AttrTypes: sensitiveFieldAttrTypes}},
+		"fields_all":                  types.SetType{ElemType: types.ObjectType{AttrTypes: fieldAttrTypes}},
+		"fields_defaulted":            types.SetType{ElemType: types.ObjectType{AttrTypes: fieldAttrTypes}},
+		"field_values":                types.MapType{ElemType: types.StringType},
+		"sensitive_field_values":      types.MapType{ElemType: types.StringType},
+		"tables":                      types.ListType{ElemType: types.ObjectType{AttrTypes: tablesSensitiveFieldsSplitAttrTypes}},
+		"tables_all":                  types.ListType{ElemType: types.ObjectType{AttrTypes: tablesMergedFieldsAttrTypes}},
+		"sensitive_fields_wo":         types.MapType{ElemType: types.StringType},
+		"sensitive_fields_wo_version": types.Int64Type,
 	}
 )
 
@@ -119,20 +126,7 @@ func readFieldsResponse(fields []client.ConfigField, planFields, planSensitiveFi
 			if ok {
 				planAttrValues := map[string]attr.Value{}
 				planAttrValues["name"] = types.StringValue(field.Name)
-				if field.EncryptedValue != nil && *field.EncryptedValue != "" {
-					diags.AddAttributeWarning(
-						path.Root("configuration"),
-						providererror.ConfigurationWarning,
-						fmt.Sprintf("Field with name %s was return encrypted by the PingFederate API. If the field is sensitive, move it to the `sensitive_fields` attribute.", field.Name),
-					)
-				}
-				// If PF sets a default for the field when the user specifies an empty value,
-				// just use the plan value and let the PF value appear in fields_all
-				if field.Value == nil || (*planValue == "" && *field.Value != *planValue) {
-					planAttrValues["value"] = types.StringPointerValue(planValue)
-				} else {
-					planAttrValues["value"] = types.StringPointerValue(field.Value)
-				}
+				planAttrValues["value"] = types.StringPointerValue(readPlannedFieldValue(field, planValue, false, "sensitive_fields", diags))
 				objVal, respDiags := types.ObjectValue(fieldAttrTypes, planAttrValues)
 				diags.Append(respDiags...)
 				plannedCleartextFields = append(plannedCleartextFields, objVal)
@@ -145,18 +139,7 @@ func readFieldsResponse(fields []client.ConfigField, planFields, planSensitiveFi
 				planEncryptedValue := plannedSensitiveFieldsEncryptedValues[field.Name]
 				planAttrValues := map[string]attr.Value{}
 				planAttrValues["name"] = types.StringValue(field.Name)
-				if field.EncryptedValue == nil && field.Value != nil && *field.Value != "" {
-					diags.AddAttributeWarning(
-						path.Root("configuration"),
-						providererror.ConfigurationWarning,
-						fmt.Sprintf("Sensitive field with name %s was returned in cleartext by the PingFederate API. If the field is not sensitive, move it to the `fields` attribute.", field.Name),
-					)
-				}
-				if field.Value == nil {
-					planAttrValues["value"] = types.StringPointerValue(planValue)
-				} else {
-					planAttrValues["value"] = types.StringPointerValue(field.Value)
-				}
+				planAttrValues["value"] = types.StringPointerValue(readPlannedFieldValue(field, planValue, true, "fields", diags))
 				if planEncryptedValue != nil {
 					planAttrValues["encrypted_value"] = types.StringPointerValue(planEncryptedValue)
 				} else {
@@ -216,6 +199,65 @@ func readFieldsResponse(fields []client.ConfigField, planFields, planSensitiveFi
 	}
 }
 
+// Get the state value of a field that is in the plan. Sensitive values are usually not returned by PingFederate, so
+// the plan value is used when the response has no value. If the field is returned with the opposite sensitivity, a
+// warning suggests moving it to moveToAttr.
+func readPlannedFieldValue(field client.ConfigField, planValue *string, sensitive bool, moveToAttr string, diags *diag.Diagnostics) *string {
+	if sensitive {
+		if field.EncryptedValue == nil && field.Value != nil && *field.Value != "" {
+			diags.AddAttributeWarning(
+				path.Root("configuration"),
+				providererror.ConfigurationWarning,
+				fmt.Sprintf("Sensitive field with name %s was returned in cleartext by the PingFederate API. If the field is not sensitive, move it to the `%s` attribute.", field.Name, moveToAttr),
+			)
+		}
+		if field.Value == nil {
+			return planValue
+		}
+		return field.Value
+	}
+
+	if field.EncryptedValue != nil && *field.EncryptedValue != "" {
+		diags.AddAttributeWarning(
+			path.Root("configuration"),
+			providererror.ConfigurationWarning,
+			fmt.Sprintf("Field with name %s was returned encrypted by the PingFederate API. If the field is sensitive, move it to the `%s` attribute.", field.Name, moveToAttr),
+		)
+	}
+	// If PF sets a default for the field when the user specifies an empty value,
+	// just use the plan value and let the PF value appear in fields_all
+	if field.Value == nil || (planValue != nil && *planValue == "" && *field.Value != "") {
+		return planValue
+	}
+	return field.Value
+}
+
+// Read the values of the fields named in the planned field_values or sensitive_field_values map
+func readFieldValuesResponse(fields []client.ConfigField, planValues types.Map, sensitive bool, diags *diag.Diagnostics) types.Map {
+	if !internaltypes.IsDefined(planValues) {
+		return types.MapNull(types.StringType)
+	}
+	moveToAttr := "sensitive_field_values"
+	if sensitive {
+		moveToAttr = "field_values"
+	}
+	responseFields := map[string]client.ConfigField{}
+	for _, field := range fields {
+		responseFields[field.Name] = field
+	}
+	values := map[string]attr.Value{}
+	for name, planValue := range planValues.Elements() {
+		field, ok := responseFields[name]
+		if !ok {
+			continue
+		}
+		values[name] = types.StringPointerValue(readPlannedFieldValue(field, planValue.(types.String).ValueStringPointer(), sensitive, moveToAttr, diags))
+	}
+	valuesMap, respDiags := types.MapValue(types.StringType, values)
+	diags.Append(respDiags...)
+	return valuesMap
+}
+
 func readRowsResponse(rows []client.ConfigRow, planRows *types.List, diags *diag.Diagnostics) pfConfigurationRowsResult {
 	var rowsMergedFields, rowsSensitiveFieldsSplit []attr.Value
 	if planRows == nil || planRows.IsNull() {
@@ -236,6 +278,8 @@ func readRowsResponse(rows []client.ConfigRow, planRows *types.List, diags *diag
 			attrValues["fields"] = rowFields.allFields
 			attrValuesSensitiveSplit["fields"] = rowFields.allCleartextFields
 			attrValuesSensitiveSplit["sensitive_fields"] = rowFields.allSensitiveFields
+			attrValuesSensitiveSplit["field_values"] = types.MapNull(types.StringType)
+			attrValuesSensitiveSplit["sensitive_field_values"] = types.MapNull(types.StringType)
 
 			rowMergedFields, respDiags := types.ObjectValue(rowsMergedFieldsAttrTypes, attrValues)
 			diags.Append(respDiags...)
@@ -253,6 +297,8 @@ func readRowsResponse(rows []client.ConfigRow, planRows *types.List, diags *diag
 			attrValuesSensitiveSplit["default_row"] = types.BoolPointerValue(rows[i].DefaultRow)
 			rowInPlan := i < len(planRowsElements)
 			var planRowFields, planRowSensitiveFields *types.Set
+			planRowFieldValues := types.MapNull(types.StringType)
+			planRowSensitiveFieldValues := types.MapNull(types.StringType)
 			if rowInPlan {
 				planRow := planRowsElements[i].(types.Object)
 				planRowFieldsVal, ok := planRow.Attributes()["fields"]
@@ -265,10 +311,21 @@ func readRowsResponse(rows []client.ConfigRow, planRows *types.List, diags *diag
 					setVal := planRowSensitiveFieldsVal.(types.Set)
 					planRowSensitiveFields = &setVal
 				}
+				planRowFieldValuesVal, ok := planRow.Attributes()["field_values"]
+				if ok {
+					planRowFieldValues = planRowFieldValuesVal.(types.Map)
+				}
+				planRowSensitiveFieldValuesVal, ok := planRow.Attributes()["sensitive_field_values"]
+				if ok {
+					planRowSensitiveFieldValues = planRowSensitiveFieldValuesVal.(types.Map)
+				}
 			}
 
 			rowFields := readFieldsResponse(rows[i].Fields, planRowFields, planRowSensitiveFields, diags)
 			attrValues["fields"] = rowFields.allFields
+			// The map forms of the fields are null unless the row is in the plan
+			attrValuesSensitiveSplit["field_values"] = readFieldValuesResponse(rows[i].Fields, planRowFieldValues, false, diags)
+			attrValuesSensitiveSplit["sensitive_field_values"] = readFieldValuesResponse(rows[i].Fields, planRowSensitiveFieldValues, true, diags)
 			if rowInPlan {
 				attrValuesSensitiveSplit["fields"] = rowFields.plannedCleartextFields
 				attrValuesSensitiveSplit["sensitive_fields"] = rowFields.plannedSensitiveFields
@@ -389,8 +446,36 @@ func ToState(configFromPlan types.Object, configuration *client.PluginConfigurat
 		listVal := planTablesValue.(types.List)
 		planTables = &listVal
 	}
+	// The write-only version is not returned by PingFederate, so it is always taken from the plan
+	sensitiveFieldsWoVersion := types.Int64Null()
+	planSensitiveFieldsWoVersion, ok := configFromPlan.Attributes()["sensitive_fields_wo_version"]
+	if ok {
+		sensitiveFieldsWoVersion = planSensitiveFieldsWoVersion.(types.Int64)
+	}
+
+	// The defaulted fields require the plugin descriptor, so they are set by ReadDefaultedFields. Until then,
+	// keep any known value from the plan.
+	fieldsDefaulted, _ := types.SetValue(types.ObjectType{AttrTypes: fieldAttrTypes}, nil)
+	planFieldsDefaulted, ok := configFromPlan.Attributes()["fields_defaulted"]
+	if ok && internaltypes.IsDefined(planFieldsDefaulted) {
+		fieldsDefaulted = planFieldsDefaulted.(types.Set)
+	}
+
+	// The map forms of the fields are only used when they are in the plan, so they are never set on import
+	planFieldValues := types.MapNull(types.StringType)
+	planFieldValuesValue, ok := configFromPlan.Attributes()["field_values"]
+	if ok {
+		planFieldValues = planFieldValuesValue.(types.Map)
+	}
+	planSensitiveFieldValues := types.MapNull(types.StringType)
+	planSensitiveFieldValuesValue, ok := configFromPlan.Attributes()["sensitive_field_values"]
+	if ok {
+		planSensitiveFieldValues = planSensitiveFieldValuesValue.(types.Map)
+	}
 
 	fields := readFieldsResponse(configuration.Fields, planFields, planSensitiveFields, &diags)
+	fieldValues := readFieldValuesResponse(configuration.Fields, planFieldValues, false, &diags)
+	sensitiveFieldValues := readFieldValuesResponse(configuration.Fields, planSensitiveFieldValues, true, &diags)
 	tables := toTablesSetValue(configuration.Tables, planTables, &diags)
 
 	fieldsAttrValue := fields.plannedCleartextFields
@@ -403,27 +488,37 @@ func ToState(configFromPlan types.Object, configuration *client.PluginConfigurat
 	}
 
 	configurationAttrValue := map[string]attr.Value{
-		"fields":           fieldsAttrValue,
-		"sensitive_fields": sensitiveFieldsAttrValue,
-		"fields_all":       fields.allFields,
-		"tables":           tablesAttrValue,
-		"tables_all":       tables.allTablesMergedFields,
+		"fields":                      fieldsAttrValue,
+		"sensitive_fields":            sensitiveFieldsAttrValue,
+		"fields_all":                  fields.allFields,
+		"fields_defaulted":            fieldsDefaulted,
+		"field_values":                fieldValues,
+		"sensitive_field_values":      sensitiveFieldValues,
+		"tables":                      tablesAttrValue,
+		"tables_all":                  tables.allTablesMergedFields,
+		"sensitive_fields_wo":         types.MapNull(types.StringType),
+		"sensitive_fields_wo_version": sensitiveFieldsWoVersion,
 	}
 	configObj, valueFromDiags := types.ObjectValue(configurationAttrTypes, configurationAttrValue)
 	diags.Append(valueFromDiags...)
 	return configObj, diags
 }
 
-// Mark fields_all and tables_all configuration as unknown if the fields and tables have changed in the plan
+// Mark fields_all, fields_defaulted and tables_all configuration as unknown if the fields, field values and tables have changed in the plan
 func MarkComputedAttrsUnknownOnChange(planConfiguration, stateConfiguration types.Object) (types.Object, diag.Diagnostics) {
 	if !internaltypes.IsDefined(planConfiguration) || !internaltypes.IsDefined(stateConfiguration) {
 		return planConfiguration, nil
 	}
 	planConfigurationAttrs := planConfiguration.Attributes()
-	planFields := planConfiguration.Attributes()["fields"]
-	stateFields := stateConfiguration.Attributes()["fields"]
-	if !planFields.Equal(stateFields) {
+	fieldsChanged := false
+	for _, attrName := range []string{"fields", "field_values", "sensitive_field_values"} {
+		if !planConfigurationAttrs[attrName].Equal(stateConfiguration.Attributes()[attrName]) {
+			fieldsChanged = true
+		}
+	}
+	if fieldsChanged {
 		planConfigurationAttrs["fields_all"] = types.SetUnknown(types.ObjectType{AttrTypes: fieldAttrTypes})
+		planConfigurationAttrs["fields_defaulted"] = types.SetUnknown(types.ObjectType{AttrTypes: fieldAttrTypes})
 	}
 
 	planTables := planConfiguration.Attributes()["tables"]
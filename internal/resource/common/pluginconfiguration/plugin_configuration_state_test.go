@@ -0,0 +1,151 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package pluginconfiguration
+
+import (
+	"cmp"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
+)
+
+func TestFieldValuesRoundTrip(t *testing.T) {
+	fieldObjectType := types.ObjectType{AttrTypes: fieldAttrTypes}
+	sensitiveFieldObjectType := types.ObjectType{AttrTypes: sensitiveFieldAttrTypes}
+	fieldsSet := func(values map[string]string) types.Set {
+		elements := []attr.Value{}
+		for name, value := range values {
+			elements = append(elements, types.ObjectValueMust(fieldAttrTypes, map[string]attr.Value{
+				"name":  types.StringValue(name),
+				"value": types.StringValue(value),
+			}))
+		}
+		return types.SetValueMust(fieldObjectType, elements)
+	}
+	sensitiveFieldsSet := func(values map[string]string) types.Set {
+		elements := []attr.Value{}
+		for name, value := range values {
+			elements = append(elements, types.ObjectValueMust(sensitiveFieldAttrTypes, map[string]attr.Value{
+				"name":            types.StringValue(name),
+				"value":           types.StringValue(value),
+				"encrypted_value": types.StringNull(),
+			}))
+		}
+		return types.SetValueMust(sensitiveFieldObjectType, elements)
+	}
+	valuesMap := func(values map[string]string) types.Map {
+		elements := map[string]attr.Value{}
+		for name, value := range values {
+			elements[name] = types.StringValue(value)
+		}
+		return types.MapValueMust(types.StringType, elements)
+	}
+	configuration := func(fields, sensitiveFields types.Set, fieldValues, sensitiveFieldValues types.Map, rowFields, rowSensitiveFields types.Set, rowFieldValues, rowSensitiveFieldValues types.Map) types.Object {
+		row := types.ObjectValueMust(rowsSensitiveFieldsSplitAttrTypes, map[string]attr.Value{
+			"fields":                 rowFields,
+			"sensitive_fields":       rowSensitiveFields,
+			"field_values":           rowFieldValues,
+			"sensitive_field_values": rowSensitiveFieldValues,
+			"default_row":            types.BoolValue(false),
+		})
+		table := types.ObjectValueMust(tablesSensitiveFieldsSplitAttrTypes, map[string]attr.Value{
+			"name": types.StringValue("Users"),
+			"rows": types.ListValueMust(types.ObjectType{AttrTypes: rowsSensitiveFieldsSplitAttrTypes}, []attr.Value{row}),
+		})
+		return types.ObjectValueMust(configurationAttrTypes, map[string]attr.Value{
+			"fields":                      fields,
+			"sensitive_fields":            sensitiveFields,
+			"fields_all":                  types.SetUnknown(fieldObjectType),
+			"fields_defaulted":            types.SetUnknown(fieldObjectType),
+			"field_values":                fieldValues,
+			"sensitive_field_values":      sensitiveFieldValues,
+			"tables":                      types.ListValueMust(types.ObjectType{AttrTypes: tablesSensitiveFieldsSplitAttrTypes}, []attr.Value{table}),
+			"tables_all":                  types.ListUnknown(types.ObjectType{AttrTypes: tablesMergedFieldsAttrTypes}),
+			"sensitive_fields_wo":         types.MapNull(types.StringType),
+			"sensitive_fields_wo_version": types.Int64Null(),
+		})
+	}
+
+	fields := map[string]string{"Email Server": "smtp.example.com", "SMTP Port": "25"}
+	sensitiveFields := map[string]string{"Password": "mypassword"}
+	rowFields := map[string]string{"Username": "joe"}
+	rowSensitiveFields := map[string]string{"Password": "joespassword"}
+	nestedPlan := configuration(fieldsSet(fields), sensitiveFieldsSet(sensitiveFields), types.MapNull(types.StringType), types.MapNull(types.StringType),
+		fieldsSet(rowFields), sensitiveFieldsSet(rowSensitiveFields), types.MapNull(types.StringType), types.MapNull(types.StringType))
+	mapPlan := configuration(fieldsSet(nil), sensitiveFieldsSet(nil), valuesMap(fields), valuesMap(sensitiveFields),
+		fieldsSet(nil), sensitiveFieldsSet(nil), valuesMap(rowFields), valuesMap(rowSensitiveFields))
+
+	// Both forms send the same request
+	sortFields := func(configuration *client.PluginConfiguration) {
+		compareFields := func(a, b client.ConfigField) int { return cmp.Compare(a.Name, b.Name) }
+		slices.SortFunc(configuration.Fields, compareFields)
+		for _, table := range configuration.Tables {
+			for _, row := range table.Rows {
+				slices.SortFunc(row.Fields, compareFields)
+			}
+		}
+	}
+	nestedRequest := ClientStruct(nestedPlan)
+	mapRequest := ClientStruct(mapPlan)
+	sortFields(nestedRequest)
+	sortFields(mapRequest)
+	if !reflect.DeepEqual(nestedRequest, mapRequest) {
+		t.Fatalf("expected the same request from both forms, got %+v and %+v", nestedRequest, mapRequest)
+	}
+
+	// PingFederate returns sensitive values encrypted
+	response := ClientStruct(nestedPlan)
+	encrypt := func(fields []client.ConfigField, sensitive map[string]string) {
+		for i := range fields {
+			if _, ok := sensitive[fields[i].Name]; ok {
+				encryptedValue := "encrypted-" + fields[i].Name
+				fields[i].Value = nil
+				fields[i].EncryptedValue = &encryptedValue
+			}
+		}
+	}
+	encrypt(response.Fields, sensitiveFields)
+	encrypt(response.Tables[0].Rows[0].Fields, rowSensitiveFields)
+	useTls := "false"
+	response.Fields = append(response.Fields, client.ConfigField{Name: "Use TLS", Value: &useTls})
+
+	nestedState, diags := ToState(nestedPlan, response, false)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	mapState, diags := ToState(mapPlan, response, false)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+
+	// Each form reads back the planned values
+	nestedAttrs := nestedState.Attributes()
+	mapAttrs := mapState.Attributes()
+	if !nestedAttrs["fields"].Equal(nestedPlan.Attributes()["fields"]) {
+		t.Errorf("expected fields %s, got %s", nestedPlan.Attributes()["fields"], nestedAttrs["fields"])
+	}
+	for _, attrName := range []string{"field_values", "sensitive_field_values", "fields", "sensitive_fields"} {
+		if !mapAttrs[attrName].Equal(mapPlan.Attributes()[attrName]) {
+			t.Errorf("expected %s %s, got %s", attrName, mapPlan.Attributes()[attrName], mapAttrs[attrName])
+		}
+	}
+	mapRow := mapAttrs["tables"].(types.List).Elements()[0].(types.Object).Attributes()["rows"].(types.List).Elements()[0].(types.Object).Attributes()
+	if !mapRow["field_values"].Equal(valuesMap(rowFields)) || !mapRow["sensitive_field_values"].Equal(valuesMap(rowSensitiveFields)) {
+		t.Errorf("expected the row field values to be read from the plan, got %s and %s", mapRow["field_values"], mapRow["sensitive_field_values"])
+	}
+	nestedRow := nestedAttrs["tables"].(types.List).Elements()[0].(types.Object).Attributes()["rows"].(types.List).Elements()[0].(types.Object).Attributes()
+	if !nestedRow["field_values"].IsNull() || !nestedRow["sensitive_field_values"].IsNull() {
+		t.Errorf("expected null row field values in the nested form, got %s and %s", nestedRow["field_values"], nestedRow["sensitive_field_values"])
+	}
+
+	// Both forms have the same computed attributes
+	for _, attrName := range []string{"fields_all", "tables_all"} {
+		if !nestedAttrs[attrName].Equal(mapAttrs[attrName]) {
+			t.Errorf("expected the same %s from both forms, got %s and %s", attrName, nestedAttrs[attrName], mapAttrs[attrName])
+		}
+	}
+}
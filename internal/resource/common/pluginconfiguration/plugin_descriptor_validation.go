@@ -0,0 +1,242 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package pluginconfiguration
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/plugindescriptor"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+)
+
+// DescriptorFunc gets the descriptor of the plugin type with the given ID, usually through the plugindescriptor.Cache of
+// the provider. The HTTP response is nil when the descriptor is cached.
+type DescriptorFunc func(ctx context.Context, id string) (*plugindescriptor.Descriptor, *http.Response, error)
+
+// Required fields must be included in the configuration, unless PingFederate populates them with a default value
+func mustBeSet(f plugindescriptor.FieldDescriptor) bool {
+	return f.Required && (f.DefaultValue == nil || *f.DefaultValue == "") && (f.DefaultForLegacyConfig == nil || *f.DefaultForLegacyConfig == "")
+}
+
+// A configuration field value from the config, with the path used for diagnostics about the field
+type configFieldValue struct {
+	value     types.String
+	sensitive bool
+	path      path.Path
+}
+
+// ValidateWithDescriptor validates the plugin configuration at configurationPath in the config against the descriptor
+// of the plugin type referenced by descriptorIdPath, so that mistakes are reported at plan time rather than when
+// PingFederate rejects or ignores them. Field and table names, required fields, the values of check box and
+// selection fields, and whether sensitive fields are set as sensitive are validated. Nothing is validated if the
+// configuration or descriptor ID are unknown, or if the descriptor can't be retrieved.
+func ValidateWithDescriptor(ctx context.Context, config tfsdk.Config, configurationPath, descriptorIdPath path.Path, getDescriptor DescriptorFunc, diags *diag.Diagnostics) {
+	var configuration types.Object
+	var descriptorId types.String
+	diags.Append(config.GetAttribute(ctx, configurationPath, &configuration)...)
+	diags.Append(config.GetAttribute(ctx, descriptorIdPath, &descriptorId)...)
+	if diags.HasError() || configuration.IsNull() || configuration.IsUnknown() || descriptorId.IsNull() || descriptorId.IsUnknown() {
+		return
+	}
+
+	descriptor, httpResp, err := getDescriptor(ctx, descriptorId.ValueString())
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			diags.AddAttributeError(descriptorIdPath, providererror.InvalidAttributeConfiguration,
+				fmt.Sprintf("No plugin descriptor with ID %s was found on the PingFederate server.", descriptorId.ValueString()))
+			return
+		}
+		diags.AddAttributeWarning(configurationPath, providererror.ConfigurationWarning,
+			fmt.Sprintf("The configuration could not be validated, because an error occurred while getting plugin descriptor %s: %s", descriptorId.ValueString(), err.Error()))
+		return
+	}
+	if descriptor.ConfigDescriptor == nil {
+		return
+	}
+
+	configurationAttrs := configuration.Attributes()
+	fields, ok := configFieldValues(configurationPath, configurationAttrs)
+	validateFields(fields, ok, descriptor.ConfigDescriptor.Fields, configurationPath, "configuration field of plugin "+descriptorId.ValueString(), diags)
+
+	tables, ok := configurationAttrs["tables"].(types.List)
+	if !ok || tables.IsNull() || tables.IsUnknown() {
+		return
+	}
+	tableColumns := map[string][]plugindescriptor.FieldDescriptor{}
+	for _, table := range descriptor.ConfigDescriptor.Tables {
+		tableColumns[table.Name] = table.Columns
+	}
+	for tableIndex, tableElement := range tables.Elements() {
+		tablePath := configurationPath.AtName("tables").AtListIndex(tableIndex)
+		table, ok := tableElement.(types.Object)
+		if !ok || table.IsNull() || table.IsUnknown() {
+			continue
+		}
+		tableName, ok := table.Attributes()["name"].(types.String)
+		if !ok || tableName.IsNull() || tableName.IsUnknown() {
+			continue
+		}
+		columns, ok := tableColumns[tableName.ValueString()]
+		if !ok {
+			diags.AddAttributeError(tablePath.AtName("name"), providererror.InvalidAttributeConfiguration,
+				fmt.Sprintf("%q is not a configuration table of plugin %s. Valid tables: %s", tableName.ValueString(), descriptorId.ValueString(), descriptorNames(descriptor.ConfigDescriptor.Tables, func(t plugindescriptor.TableDescriptor) string { return t.Name })))
+			continue
+		}
+		rows, ok := table.Attributes()["rows"].(types.List)
+		if !ok || rows.IsNull() || rows.IsUnknown() {
+			continue
+		}
+		for rowIndex, rowElement := range rows.Elements() {
+			rowPath := tablePath.AtName("rows").AtListIndex(rowIndex)
+			row, ok := rowElement.(types.Object)
+			if !ok || row.IsNull() || row.IsUnknown() {
+				continue
+			}
+			fields, ok := configFieldValues(rowPath, row.Attributes())
+			validateFields(fields, ok, columns, rowPath, fmt.Sprintf("column of table %q", tableName.ValueString()), diags)
+		}
+	}
+}
+
+// Get the values of the fields, sensitive_fields, and sensitive_fields_wo attributes of a configuration or table row,
+// keyed by field name. The returned bool is false if any field name is unknown, in which case the map may be incomplete.
+func configFieldValues(parentPath path.Path, attrs map[string]attr.Value) (map[string]configFieldValue, bool) {
+	result := map[string]configFieldValue{}
+	allKnown := true
+	for _, attrName := range []string{"fields", "sensitive_fields"} {
+		fieldsSet, ok := attrs[attrName].(types.Set)
+		if !ok || fieldsSet.IsNull() {
+			continue
+		}
+		if fieldsSet.IsUnknown() {
+			allKnown = false
+			continue
+		}
+		for _, fieldElement := range fieldsSet.Elements() {
+			field, ok := fieldElement.(types.Object)
+			if !ok || field.IsNull() {
+				continue
+			}
+			name, nameOk := field.Attributes()["name"].(types.String)
+			if field.IsUnknown() || !nameOk || name.IsUnknown() {
+				allKnown = false
+				continue
+			}
+			value, _ := field.Attributes()["value"].(types.String)
+			result[name.ValueString()] = configFieldValue{
+				value:     value,
+				sensitive: attrName == "sensitive_fields",
+				path:      parentPath.AtName(attrName).AtSetValue(field),
+			}
+		}
+	}
+	for _, attrName := range []string{"field_values", "sensitive_field_values", "sensitive_fields_wo"} {
+		fieldsMap, ok := attrs[attrName].(types.Map)
+		if !ok || fieldsMap.IsNull() {
+			continue
+		}
+		if fieldsMap.IsUnknown() {
+			allKnown = false
+		}
+		for name, value := range fieldsMap.Elements() {
+			stringValue, _ := value.(types.String)
+			result[name] = configFieldValue{
+				value:     stringValue,
+				sensitive: attrName != "field_values",
+				path:      parentPath.AtName(attrName).AtMapKey(name),
+			}
+		}
+	}
+	return result, allKnown
+}
+
+// Validate the fields of a configuration or table row against the field descriptors. Missing required fields are
+// only reported when all field names are known.
+func validateFields(fields map[string]configFieldValue, allNamesKnown bool, descriptors []plugindescriptor.FieldDescriptor, parentPath path.Path, fieldDescription string, diags *diag.Diagnostics) {
+	fieldDescriptors := map[string]plugindescriptor.FieldDescriptor{}
+	for _, descriptor := range descriptors {
+		fieldDescriptors[descriptor.Name] = descriptor
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(fields)) {
+		field := fields[name]
+		descriptor, ok := fieldDescriptors[name]
+		if !ok {
+			diags.AddAttributeError(field.path, providererror.InvalidAttributeConfiguration,
+				fmt.Sprintf("%q is not a %s. Valid names: %s", name, fieldDescription, descriptorNames(descriptors, func(f plugindescriptor.FieldDescriptor) string { return f.Name })))
+			continue
+		}
+		if descriptor.Sensitive() && !field.sensitive {
+			diags.AddAttributeWarning(field.path, providererror.ConfigurationWarning,
+				fmt.Sprintf("Field with name %s is sensitive, so PingFederate will return it encrypted. Move it to the `sensitive_fields` attribute.", name))
+		}
+		if !descriptor.Sensitive() && field.sensitive {
+			diags.AddAttributeWarning(field.path, providererror.ConfigurationWarning,
+				fmt.Sprintf("Field with name %s is not sensitive, so PingFederate will return its value in cleartext. Move it to the `fields` attribute.", name))
+		}
+		validateFieldValue(field, descriptor, diags)
+	}
+
+	if !allNamesKnown {
+		return
+	}
+	for _, descriptor := range descriptors {
+		if _, ok := fields[descriptor.Name]; !ok && mustBeSet(descriptor) {
+			diags.AddAttributeError(parentPath, providererror.InvalidAttributeConfiguration,
+				fmt.Sprintf("Field with name %s is required and has no default value, so it must be included in the configuration.", descriptor.Name))
+		}
+	}
+}
+
+// Validate the value of a check box or selection field
+func validateFieldValue(field configFieldValue, descriptor plugindescriptor.FieldDescriptor, diags *diag.Diagnostics) {
+	if field.value.IsNull() || field.value.IsUnknown() {
+		return
+	}
+	value := field.value.ValueString()
+	switch descriptor.Type {
+	case plugindescriptor.FieldTypeCheckBox:
+		if value != "true" && value != "false" {
+			diags.AddAttributeError(field.path, providererror.InvalidAttributeConfiguration,
+				fmt.Sprintf("Field with name %s is a check box, so its value must be \"true\" or \"false\", got: %q", descriptor.Name, value))
+		}
+	case plugindescriptor.FieldTypeRadioGroup, plugindescriptor.FieldTypeSelect, plugindescriptor.FieldTypeFilterableSelect:
+		if len(descriptor.OptionValues) == 0 || (value == "" && !descriptor.Required) {
+			return
+		}
+		options := make([]string, 0, len(descriptor.OptionValues))
+		for _, option := range descriptor.OptionValues {
+			if option.Value == value {
+				return
+			}
+			options = append(options, option.Value)
+		}
+		message := fmt.Sprintf("Value %q is not an option of field with name %s. Valid options: %s", value, descriptor.Name, strings.Join(options, ", "))
+		if descriptor.Type == plugindescriptor.FieldTypeRadioGroup {
+			diags.AddAttributeError(field.path, providererror.InvalidAttributeConfiguration, message)
+		} else {
+			// The options of select fields may be other configuration on the server, such as data stores,
+			// which could be created in the same apply as this plugin
+			diags.AddAttributeWarning(field.path, providererror.ConfigurationWarning, message+". If the value refers to configuration that has not been created yet, this warning can be ignored.")
+		}
+	}
+}
+
+func descriptorNames[T any](descriptors []T, name func(T) string) string {
+	names := make([]string, 0, len(descriptors))
+	for _, descriptor := range descriptors {
+		names = append(names, name(descriptor))
+	}
+	slices.Sort(names)
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,92 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package pluginconfiguration
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/plugindescriptor"
+)
+
+func TestValidateFields(t *testing.T) {
+	descriptors := []plugindescriptor.FieldDescriptor{
+		{Name: "Email Server", Type: "TEXT", Required: true},
+		{Name: "Use TLS", Type: plugindescriptor.FieldTypeCheckBox},
+		{Name: "Password", Type: "TEXT", Encrypted: true},
+	}
+	field := func(name, value string, sensitive bool) configFieldValue {
+		return configFieldValue{
+			value:     types.StringValue(value),
+			sensitive: sensitive,
+			path:      path.Root("configuration").AtName("fields").AtMapKey(name),
+		}
+	}
+
+	testCases := []struct {
+		name             string
+		fields           map[string]configFieldValue
+		allNamesKnown    bool
+		expectedErrors   int
+		expectedWarnings int
+	}{
+		{
+			name: "valid fields",
+			fields: map[string]configFieldValue{
+				"Email Server": field("Email Server", "smtp.example.com", false),
+				"Use TLS":      field("Use TLS", "true", false),
+				"Password":     field("Password", "pw", true),
+			},
+			allNamesKnown: true,
+		},
+		{
+			name: "sensitive field in fields",
+			fields: map[string]configFieldValue{
+				"Email Server": field("Email Server", "smtp.example.com", false),
+				"Password":     field("Password", "pw", false),
+			},
+			allNamesKnown:    true,
+			expectedWarnings: 1,
+		},
+		{
+			name: "non-sensitive field in sensitive_fields",
+			fields: map[string]configFieldValue{
+				"Email Server": field("Email Server", "smtp.example.com", true),
+			},
+			allNamesKnown:    true,
+			expectedWarnings: 1,
+		},
+		{
+			name: "unknown field and invalid check box value",
+			fields: map[string]configFieldValue{
+				"Email Server":  field("Email Server", "smtp.example.com", false),
+				"Email Servers": field("Email Servers", "smtp.example.com", false),
+				"Use TLS":       field("Use TLS", "yes", false),
+			},
+			allNamesKnown:  true,
+			expectedErrors: 2,
+		},
+		{
+			name:           "missing required field",
+			fields:         map[string]configFieldValue{},
+			allNamesKnown:  true,
+			expectedErrors: 1,
+		},
+		{
+			name:   "missing required field with unknown names",
+			fields: map[string]configFieldValue{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateFields(testCase.fields, testCase.allNamesKnown, descriptors, path.Root("configuration"), "configuration field", &diags)
+			if diags.ErrorsCount() != testCase.expectedErrors || diags.WarningsCount() != testCase.expectedWarnings {
+				t.Errorf("expected %d errors and %d warnings, got %v", testCase.expectedErrors, testCase.expectedWarnings, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package plugindescriptor
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// Cache holds the descriptors retrieved by a provider instance, keyed by plugin family and descriptor ID, so that
+// validating and reading plugin configuration doesn't get the same descriptor from PingFederate on every plan and
+// CRUD operation. Failed requests are not cached. A nil Cache gets the descriptor on every call.
+type Cache struct {
+	mutex       sync.Mutex
+	descriptors map[string]*Descriptor
+}
+
+// NewCache creates an empty descriptor cache
+func NewCache() *Cache {
+	return &Cache{
+		descriptors: map[string]*Descriptor{},
+	}
+}
+
+// Get returns the cached descriptor with the given plugin family and ID, or gets and caches it with getDescriptor.
+// The HTTP response is only returned when the descriptor is retrieved from PingFederate.
+func (c *Cache) Get(ctx context.Context, family, id string, getDescriptor func(ctx context.Context, id string) (*http.Response, error)) (*Descriptor, *http.Response, error) {
+	key := family + "/" + id
+	if c != nil {
+		c.mutex.Lock()
+		descriptor, ok := c.descriptors[key]
+		c.mutex.Unlock()
+		if ok {
+			return descriptor, nil, nil
+		}
+	}
+
+	httpResp, err := getDescriptor(ctx, id)
+	if err != nil {
+		return nil, httpResp, err
+	}
+	descriptor, err := FromResponse(httpResp)
+	if err != nil {
+		return nil, httpResp, err
+	}
+	if c != nil {
+		c.mutex.Lock()
+		c.descriptors[key] = descriptor
+		c.mutex.Unlock()
+	}
+	return descriptor, httpResp, nil
+}
@@ -0,0 +1,73 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package plugindescriptor_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/plugindescriptor"
+)
+
+func TestCache(t *testing.T) {
+	ctx := context.Background()
+	requests := map[string]int{}
+	fail := false
+	getDescriptor := func(ctx context.Context, id string) (*http.Response, error) {
+		requests[id]++
+		if fail {
+			return &http.Response{StatusCode: http.StatusInternalServerError, Body: io.NopCloser(strings.NewReader(""))}, errors.New("500 Internal Server Error")
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"id":"` + id + `","configDescriptor":{"fields":[{"name":"Port","type":"TEXT","defaultValue":"25"}]}}`))}, nil
+	}
+
+	cache := plugindescriptor.NewCache()
+	for range 2 {
+		descriptor, _, err := cache.Get(ctx, "NotificationPublishers", "smtp", getDescriptor)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if descriptor.Id != "smtp" || len(descriptor.ConfigDescriptor.Fields) != 1 || *descriptor.ConfigDescriptor.Fields[0].DefaultValue != "25" {
+			t.Errorf("unexpected descriptor: %+v", descriptor)
+		}
+	}
+	if requests["smtp"] != 1 {
+		t.Errorf("expected the descriptor to be retrieved once, got %d requests", requests["smtp"])
+	}
+
+	// Descriptors of other plugin families are cached separately
+	if _, _, err := cache.Get(ctx, "SecretManagers", "smtp", getDescriptor); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests["smtp"] != 2 {
+		t.Errorf("expected the descriptor of another plugin family to be retrieved, got %d requests", requests["smtp"])
+	}
+
+	// Failed requests are not cached
+	fail = true
+	for range 2 {
+		_, httpResp, err := cache.Get(ctx, "NotificationPublishers", "failing", getDescriptor)
+		if err == nil || httpResp == nil || httpResp.StatusCode != http.StatusInternalServerError {
+			t.Errorf("expected the error and response of the failed request, got %v", err)
+		}
+	}
+	if requests["failing"] != 2 {
+		t.Errorf("expected failed requests to be retried, got %d requests", requests["failing"])
+	}
+
+	// A nil cache gets the descriptor on every call
+	fail = false
+	var nilCache *plugindescriptor.Cache
+	for range 2 {
+		if _, _, err := nilCache.Get(ctx, "NotificationPublishers", "uncached", getDescriptor); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if requests["uncached"] != 2 {
+		t.Errorf("expected a nil cache to get the descriptor on every call, got %d requests", requests["uncached"])
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package plugindescriptor
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+// Descriptor is the descriptor of a plugin type. Descriptors are decoded from the body of the API response rather
+// than read from the client structs, because the client structs drop properties of specific field types, such as
+// the options of selection fields and whether text fields are encrypted. Not every plugin family uses every
+// attribute contract.
+type Descriptor struct {
+	Id                             string            `json:"id"`
+	Name                           string            `json:"name"`
+	ClassName                      string            `json:"className"`
+	AttributeContract              []string          `json:"attributeContract"`
+	SupportsExtendedContract       bool              `json:"supportsExtendedContract"`
+	TokenEndpointAttributeContract []string          `json:"tokenEndpointAttributeContract"`
+	GroupAttributeContract         []string          `json:"groupAttributeContract"`
+	SupportsGroupExtendedContract  bool              `json:"supportsGroupExtendedContract"`
+	ConfigDescriptor               *ConfigDescriptor `json:"configDescriptor"`
+}
+
+type ConfigDescriptor struct {
+	Description *string           `json:"description"`
+	Fields      []FieldDescriptor `json:"fields"`
+	Tables      []TableDescriptor `json:"tables"`
+}
+
+type TableDescriptor struct {
+	Name              string            `json:"name"`
+	Label             *string           `json:"label"`
+	Description       *string           `json:"description"`
+	RequireDefaultRow bool              `json:"requireDefaultRow"`
+	Columns           []FieldDescriptor `json:"columns"`
+}
+
+type FieldDescriptor struct {
+	Type                   string        `json:"type"`
+	Name                   string        `json:"name"`
+	Label                  *string       `json:"label"`
+	Description            *string       `json:"description"`
+	DefaultValue           *string       `json:"defaultValue"`
+	DefaultForLegacyConfig *string       `json:"defaultForLegacyConfig"`
+	Required               bool          `json:"required"`
+	Advanced               bool          `json:"advanced"`
+	Encrypted              bool          `json:"encrypted"`
+	OptionValues           []OptionValue `json:"optionValues"`
+}
+
+type OptionValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+const (
+	FieldTypeCheckBox         = "CHECK_BOX"
+	FieldTypeRadioGroup       = "RADIO_GROUP"
+	FieldTypeSelect           = "SELECT"
+	FieldTypeFilterableSelect = "FILTERABLE_SELECT"
+	FieldTypeHashedText       = "HASHED_TEXT"
+)
+
+// Sensitive returns whether the field is returned by PingFederate as an encrypted value, so that it should be
+// configured in sensitive_fields. Hashed fields and encrypted text fields are sensitive.
+func (f FieldDescriptor) Sensitive() bool {
+	return f.Type == FieldTypeHashedText || f.Encrypted
+}
+
+// FromResponse decodes the descriptor in the body of the response to a get descriptor request
+func FromResponse(httpResp *http.Response) (*Descriptor, error) {
+	var descriptor Descriptor
+	if err := decode(httpResp, &descriptor); err != nil {
+		return nil, err
+	}
+	return &descriptor, nil
+}
+
+// ListFromResponse decodes the descriptors in the body of the response to a get descriptors request
+func ListFromResponse(httpResp *http.Response) ([]Descriptor, error) {
+	var descriptors struct {
+		Items []Descriptor `json:"items"`
+	}
+	if err := decode(httpResp, &descriptors); err != nil {
+		return nil, err
+	}
+	return descriptors.Items, nil
+}
+
+func decode(httpResp *http.Response, v any) error {
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}
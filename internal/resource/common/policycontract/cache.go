@@ -0,0 +1,58 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package policycontract
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
+)
+
+// Cache holds the names of the attributes of the authentication policy contracts retrieved by a provider instance,
+// keyed by contract ID, so that mapping resources referring to the same contract don't each get it from PingFederate
+// when planning. Failed requests are not cached. A nil Cache gets the contract on every call.
+type Cache struct {
+	mutex      sync.Mutex
+	attributes map[string]map[string]bool
+}
+
+// NewCache creates an empty contract cache
+func NewCache() *Cache {
+	return &Cache{
+		attributes: map[string]map[string]bool{},
+	}
+}
+
+// AttributeNames returns the cached names of the core and extended attributes of the contract with the given ID, or
+// gets the contract with getContract and caches them. The HTTP response is only returned when the contract is
+// retrieved from PingFederate.
+func (c *Cache) AttributeNames(ctx context.Context, id string, getContract func(ctx context.Context, id string) (*client.AuthenticationPolicyContract, *http.Response, error)) (map[string]bool, *http.Response, error) {
+	if c != nil {
+		c.mutex.Lock()
+		names, ok := c.attributes[id]
+		c.mutex.Unlock()
+		if ok {
+			return names, nil, nil
+		}
+	}
+
+	contract, httpResp, err := getContract(ctx, id)
+	if err != nil {
+		return nil, httpResp, err
+	}
+	names := map[string]bool{}
+	for _, attribute := range contract.CoreAttributes {
+		names[attribute.Name] = true
+	}
+	for _, attribute := range contract.ExtendedAttributes {
+		names[attribute.Name] = true
+	}
+	if c != nil {
+		c.mutex.Lock()
+		c.attributes[id] = names
+		c.mutex.Unlock()
+	}
+	return names, httpResp, nil
+}
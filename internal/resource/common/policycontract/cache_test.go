@@ -0,0 +1,70 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package policycontract_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/policycontract"
+)
+
+func TestCache(t *testing.T) {
+	ctx := context.Background()
+	requests := map[string]int{}
+	fail := false
+	getContract := func(ctx context.Context, id string) (*client.AuthenticationPolicyContract, *http.Response, error) {
+		requests[id]++
+		if fail {
+			return nil, &http.Response{StatusCode: http.StatusNotFound}, errors.New("404 Not Found")
+		}
+		return &client.AuthenticationPolicyContract{
+			Id:                 &id,
+			CoreAttributes:     []client.AuthenticationPolicyContractAttribute{{Name: "subject"}},
+			ExtendedAttributes: []client.AuthenticationPolicyContractAttribute{{Name: "firstName"}, {Name: "lastName"}},
+		}, &http.Response{StatusCode: http.StatusOK}, nil
+	}
+	expectedNames := map[string]bool{"subject": true, "firstName": true, "lastName": true}
+
+	cache := policycontract.NewCache()
+	for range 2 {
+		names, _, err := cache.AttributeNames(ctx, "contract", getContract)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(names, expectedNames) {
+			t.Errorf("expected attribute names %v, got %v", expectedNames, names)
+		}
+	}
+	if requests["contract"] != 1 {
+		t.Errorf("expected the contract to be retrieved once, got %d requests", requests["contract"])
+	}
+
+	// Failed requests are not cached, since the contract may be created later
+	fail = true
+	for range 2 {
+		_, httpResp, err := cache.AttributeNames(ctx, "missing", getContract)
+		if err == nil || httpResp == nil || httpResp.StatusCode != http.StatusNotFound {
+			t.Errorf("expected the error and response of the failed request, got %v", err)
+		}
+	}
+	if requests["missing"] != 2 {
+		t.Errorf("expected failed requests to be retried, got %d requests", requests["missing"])
+	}
+
+	// A nil cache gets the contract on every call
+	fail = false
+	var nilCache *policycontract.Cache
+	for range 2 {
+		if _, _, err := nilCache.AttributeNames(ctx, "uncached", getContract); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if requests["uncached"] != 2 {
+		t.Errorf("expected a nil cache to get the contract on every call, got %d requests", requests["uncached"])
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package administrativeaccount
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &administrativeAccountPasswordResetAction{}
+	_ action.ActionWithConfigure = &administrativeAccountPasswordResetAction{}
+)
+
+// AdministrativeAccountPasswordResetAction is a helper function to simplify the provider implementation.
+func AdministrativeAccountPasswordResetAction() action.Action {
+	return &administrativeAccountPasswordResetAction{}
+}
+
+// administrativeAccountPasswordResetAction is the action implementation.
+type administrativeAccountPasswordResetAction struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type administrativeAccountPasswordResetActionModel struct {
+	NewPassword types.String `tfsdk:"new_password"`
+	Username    types.String `tfsdk:"username"`
+}
+
+// Metadata returns the action type name.
+func (a *administrativeAccountPasswordResetAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_administrative_account_password_reset"
+}
+
+func (a *administrativeAccountPasswordResetAction) Configure(_ context.Context, req action.ConfigureRequest, _ *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	a.providerConfig = providerCfg.ProviderConfig
+	a.apiClient = providerCfg.ApiClient
+}
+
+// Schema defines the schema for the action.
+func (a *administrativeAccountPasswordResetAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Action to reset the password of a native administrative account. " +
+			"If the account is managed by a `pingfederate_administrative_account` resource, the resource's `encrypted_password` will change on the next refresh.",
+		Attributes: map[string]schema.Attribute{
+			"new_password": schema.StringAttribute{
+				Description: "The new password for the account. This attribute is write-only, so it can be set from an ephemeral value.",
+				Required:    true,
+				WriteOnly:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "Username of the administrative account.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (a *administrativeAccountPasswordResetAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data administrativeAccountPasswordResetActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resetRequest := a.apiClient.AdministrativeAccountsAPI.ResetPassword(config.AuthContext(ctx, a.providerConfig), data.Username.ValueString())
+	resetRequest = resetRequest.Body(*client.NewUserCredentials(data.NewPassword.ValueString()))
+	_, httpResp, err := resetRequest.Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while resetting the password of the administrative account", err, httpResp)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Reset the password of administrative account %s", data.Username.ValueString()),
+	})
+}
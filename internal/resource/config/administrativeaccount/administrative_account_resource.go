@@ -5,12 +5,15 @@ package administrativeaccount
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -27,6 +30,7 @@ var (
 	_ resource.Resource                = &administrativeAccountsResource{}
 	_ resource.ResourceWithConfigure   = &administrativeAccountsResource{}
 	_ resource.ResourceWithImportState = &administrativeAccountsResource{}
+	_ resource.ResourceWithIdentity    = &administrativeAccountsResource{}
 )
 
 // AdministrativeAccountResource is a helper function to simplify the provider implementation.
@@ -48,6 +52,8 @@ type administrativeAccountResourceModel struct {
 	EmailAddress      types.String `tfsdk:"email_address"`
 	Id                types.String `tfsdk:"id"`
 	Password          types.String `tfsdk:"password"`
+	PasswordWo        types.String `tfsdk:"password_wo"`
+	PasswordWoVersion types.Int64  `tfsdk:"password_wo_version"`
 	EncryptedPassword types.String `tfsdk:"encrypted_password"`
 	PhoneNumber       types.String `tfsdk:"phone_number"`
 	Roles             types.Set    `tfsdk:"roles"`
@@ -93,15 +99,31 @@ func (r *administrativeAccountsResource) Schema(ctx context.Context, req resourc
 				},
 			},
 			"password": schema.StringAttribute{
-				Description: "Password for the Account. This field is immutable and will trigger a replacement plan if changed. Either this attribute or `encrypted_password` must be specified.",
+				Description: "Password for the Account. This field is immutable and will trigger a replacement plan if changed. Exactly one of this attribute, `password_wo`, or `encrypted_password` must be specified.",
 				Optional:    true,
 				Sensitive:   true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"password_wo": schema.StringAttribute{
+				Description: "Write-only password for the Account, which is never stored in Terraform state. The password is only sent to PingFederate when the account is created, so change `password_wo_version` to replace the account with a new password. Exactly one of this attribute, `password`, or `encrypted_password` must be specified.",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Description: "A version number for `password_wo`. This field is immutable and will trigger a replacement plan if changed.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("password_wo")),
+				},
+			},
 			"encrypted_password": schema.StringAttribute{
-				Description: "Encrypted password for the account. This field holds the value returned from PingFederate and used for updating an existing Administrative Account. Either this attribute or `password` must be specified.",
+				Description: "Encrypted password for the account. This field holds the value returned from PingFederate and used for updating an existing Administrative Account. Exactly one of this attribute, `password`, or `password_wo` must be specified.",
 				Computed:    true,
 				Optional:    true,
 				PlanModifiers: []planmodifier.String{
@@ -109,7 +131,10 @@ func (r *administrativeAccountsResource) Schema(ctx context.Context, req resourc
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
-					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("password")),
+					stringvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("password"),
+						path.MatchRelative().AtParent().AtName("password_wo"),
+					),
 				},
 			},
 			"phone_number": schema.StringAttribute{
@@ -167,7 +192,11 @@ func addOptionalAdministrativeAccountFields(ctx context.Context, addRequest *cli
 	}
 
 	if isCreate {
-		addRequest.Password = plan.Password.ValueStringPointer()
+		if internaltypes.IsDefined(plan.PasswordWo) {
+			addRequest.Password = plan.PasswordWo.ValueStringPointer()
+		} else {
+			addRequest.Password = plan.Password.ValueStringPointer()
+		}
 	}
 
 	if internaltypes.IsDefined(plan.EncryptedPassword) {
@@ -218,10 +247,13 @@ func readAdministrativeAccountResourceResponse(ctx context.Context, r *client.Ad
 		} else {
 			state.EncryptedPassword = types.StringPointerValue(r.EncryptedPassword)
 		}
+		state.PasswordWoVersion = plan.PasswordWoVersion
 	} else {
 		state.Password = types.StringNull()
+		state.PasswordWoVersion = types.Int64Null()
 		state.EncryptedPassword = types.StringPointerValue(r.EncryptedPassword)
 	}
+	state.PasswordWo = types.StringNull()
 	state.Active = types.BoolPointerValue(r.Active)
 	state.Description = types.StringPointerValue(r.Description)
 	state.Auditor = types.BoolPointerValue(r.Auditor)
@@ -340,6 +372,17 @@ func (r *administrativeAccountsResource) Delete(ctx context.Context, req resourc
 	}
 }
 
+func (r *administrativeAccountsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"username": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Username for the Administrative Account.",
+			},
+		},
+	}
+}
+
 func (r *administrativeAccountsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("username"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("username"), path.Root("username"), req, resp)
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -30,6 +31,7 @@ var (
 	_ resource.Resource                = &authenticationApiApplicationResource{}
 	_ resource.ResourceWithConfigure   = &authenticationApiApplicationResource{}
 	_ resource.ResourceWithImportState = &authenticationApiApplicationResource{}
+	_ resource.ResourceWithIdentity    = &authenticationApiApplicationResource{}
 
 	emptyStringSet, _ = types.SetValue(types.StringType, nil)
 	customId          = "application_id"
@@ -276,7 +278,18 @@ func (r *authenticationApiApplicationResource) Delete(ctx context.Context, req r
 
 }
 
+func (r *authenticationApiApplicationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"application_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The persistent, unique ID for the Authentication API application.",
+			},
+		},
+	}
+}
+
 func (r *authenticationApiApplicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("application_id"), req, resp)
+	// Retrieve import ID or identity and save to id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("application_id"), path.Root("application_id"), req, resp)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
@@ -24,6 +25,7 @@ var (
 	_ resource.Resource                = &authenticationPoliciesFragmentResource{}
 	_ resource.ResourceWithConfigure   = &authenticationPoliciesFragmentResource{}
 	_ resource.ResourceWithImportState = &authenticationPoliciesFragmentResource{}
+	_ resource.ResourceWithIdentity    = &authenticationPoliciesFragmentResource{}
 )
 
 // AuthenticationPoliciesFragmentResource is a helper function to simplify the provider implementation.
@@ -248,7 +250,18 @@ func (r *authenticationPoliciesFragmentResource) Delete(ctx context.Context, req
 	}
 }
 
+func (r *authenticationPoliciesFragmentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"fragment_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The authentication policy fragment ID. ID is unique.",
+			},
+		},
+	}
+}
+
 func (r *authenticationPoliciesFragmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to fragment_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("fragment_id"), req, resp)
+	// Retrieve import ID or identity and save to fragment_id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("fragment_id"), path.Root("fragment_id"), req, resp)
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
@@ -27,6 +28,7 @@ var (
 	_ resource.Resource                = &authenticationPolicyContractResource{}
 	_ resource.ResourceWithConfigure   = &authenticationPolicyContractResource{}
 	_ resource.ResourceWithImportState = &authenticationPolicyContractResource{}
+	_ resource.ResourceWithIdentity    = &authenticationPolicyContractResource{}
 
 	coreAttributesDefaultObjAttrType = map[string]attr.Type{
 		"name": types.StringType,
@@ -265,7 +267,18 @@ func (r *authenticationPolicyContractResource) Delete(ctx context.Context, req r
 
 }
 
+func (r *authenticationPolicyContractResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"contract_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The persistent, unique ID for the authentication policy contract.",
+			},
+		},
+	}
+}
+
 func (r *authenticationPolicyContractResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("contract_id"), req, resp)
+	// Retrieve import ID or identity and save to id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("contract_id"), path.Root("contract_id"), req, resp)
 }
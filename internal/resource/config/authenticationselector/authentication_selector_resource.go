@@ -4,12 +4,14 @@ package authenticationselector
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
@@ -20,6 +22,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/pluginconfiguration"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/plugindescriptor"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/resourcelink"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
@@ -31,6 +34,7 @@ var (
 	_ resource.Resource                = &authenticationSelectorResource{}
 	_ resource.ResourceWithConfigure   = &authenticationSelectorResource{}
 	_ resource.ResourceWithImportState = &authenticationSelectorResource{}
+	_ resource.ResourceWithIdentity    = &authenticationSelectorResource{}
 
 	extendedAttributesElemType = types.ObjectType{
 		AttrTypes: map[string]attr.Type{
@@ -176,7 +180,15 @@ func (r *authenticationSelectorResource) ModifyPlan(ctx context.Context, req res
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	var respDiags diag.Diagnostics
 
-	if plan == nil || state == nil {
+	if plan == nil {
+		return
+	}
+
+	// Validate the configuration against the descriptor of the plugin type
+	pluginconfiguration.ValidateWithDescriptor(ctx, req.Config, path.Root("configuration"), path.Root("plugin_descriptor_ref").AtName("id"),
+		r.getPluginDescriptor, &resp.Diagnostics)
+
+	if state == nil {
 		return
 	}
 
@@ -186,6 +198,14 @@ func (r *authenticationSelectorResource) ModifyPlan(ctx context.Context, req res
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
 }
 
+// Get the descriptor of the plugin type from PingFederate, for validating and reading the configuration
+func (r *authenticationSelectorResource) getPluginDescriptor(ctx context.Context, descriptorId string) (*plugindescriptor.Descriptor, *http.Response, error) {
+	return r.providerConfig.PluginDescriptors.Get(ctx, "AuthenticationSelectors", descriptorId, func(ctx context.Context, descriptorId string) (*http.Response, error) {
+		_, httpResp, err := r.apiClient.AuthenticationSelectorsAPI.GetAuthenticationSelectorDescriptorsById(config.AuthContext(ctx, r.providerConfig), descriptorId).Execute()
+		return httpResp, err
+	})
+}
+
 func readAuthenticationSelectorsResponse(ctx context.Context, r *client.AuthenticationSelector, state *authenticationSelectorResourceModel, configurationFromPlan types.Object, isImportRead bool) diag.Diagnostics {
 	var diags, objDiags diag.Diagnostics
 
@@ -248,6 +268,7 @@ func (r *authenticationSelectorResource) Create(ctx context.Context, req resourc
 
 	diags = readAuthenticationSelectorsResponse(ctx, authenticationSelectorResponse, &state, plan.Configuration, false)
 	resp.Diagnostics.Append(diags...)
+	state.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, state.Configuration, authenticationSelectorResponse.PluginDescriptorRef.Id, false, r.getPluginDescriptor, &resp.Diagnostics)
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -278,6 +299,7 @@ func (r *authenticationSelectorResource) Read(ctx context.Context, req resource.
 	// Read the response into the state
 	diags = readAuthenticationSelectorsResponse(ctx, apiReadAuthenticationSelectors, &state, state.Configuration, isImportRead)
 	resp.Diagnostics.Append(diags...)
+	state.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, state.Configuration, apiReadAuthenticationSelectors.PluginDescriptorRef.Id, isImportRead, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -327,6 +349,7 @@ func (r *authenticationSelectorResource) Update(ctx context.Context, req resourc
 	var state authenticationSelectorResourceModel
 	diags = readAuthenticationSelectorsResponse(ctx, updateAuthenticationSelectorsResponse, &state, plan.Configuration, false)
 	resp.Diagnostics.Append(diags...)
+	state.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, state.Configuration, updateAuthenticationSelectorsResponse.PluginDescriptorRef.Id, false, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Update computed values
 	diags = resp.State.Set(ctx, state)
@@ -347,8 +370,19 @@ func (r *authenticationSelectorResource) Delete(ctx context.Context, req resourc
 	}
 }
 
+func (r *authenticationSelectorResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"selector_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the plugin instance.",
+			},
+		},
+	}
+}
+
 func (r *authenticationSelectorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("selector_id"), req, resp)
+	// Retrieve import ID or identity and save to id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("selector_id"), path.Root("selector_id"), req, resp)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
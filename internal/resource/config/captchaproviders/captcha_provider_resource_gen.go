@@ -6,12 +6,14 @@ package captchaproviders
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -22,6 +24,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/pluginconfiguration"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/plugindescriptor"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 )
@@ -30,6 +33,7 @@ var (
 	_ resource.Resource                = &captchaProviderResource{}
 	_ resource.ResourceWithConfigure   = &captchaProviderResource{}
 	_ resource.ResourceWithImportState = &captchaProviderResource{}
+	_ resource.ResourceWithIdentity    = &captchaProviderResource{}
 
 	customId = "provider_id"
 )
@@ -123,6 +127,11 @@ func (r *captchaProviderResource) ModifyPlan(ctx context.Context, req resource.M
 	if plan == nil {
 		return
 	}
+
+	// Validate the configuration against the descriptor of the plugin type
+	pluginconfiguration.ValidateWithDescriptor(ctx, req.Config, path.Root("configuration"), path.Root("plugin_descriptor_ref").AtName("id"),
+		r.getPluginDescriptor, &resp.Diagnostics)
+
 	var state *captchaProviderResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if state == nil {
@@ -135,6 +144,14 @@ func (r *captchaProviderResource) ModifyPlan(ctx context.Context, req resource.M
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
 }
 
+// Get the descriptor of the plugin type from PingFederate, for validating and reading the configuration
+func (r *captchaProviderResource) getPluginDescriptor(ctx context.Context, descriptorId string) (*plugindescriptor.Descriptor, *http.Response, error) {
+	return r.providerConfig.PluginDescriptors.Get(ctx, "CaptchaProviders", descriptorId, func(ctx context.Context, descriptorId string) (*http.Response, error) {
+		_, httpResp, err := r.apiClient.CaptchaProvidersAPI.GetCaptchaProviderPluginDescriptor(config.AuthContext(ctx, r.providerConfig), descriptorId).Execute()
+		return httpResp, err
+	})
+}
+
 func (model *captchaProviderResourceModel) buildClientStruct() (*client.CaptchaProvider, diag.Diagnostics) {
 	result := &client.CaptchaProvider{}
 	var respDiags diag.Diagnostics
@@ -227,6 +244,7 @@ func (r *captchaProviderResource) Create(ctx context.Context, req resource.Creat
 
 	// Read response into the model
 	resp.Diagnostics.Append(data.readClientResponse(responseData, false)...)
+	data.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, data.Configuration, responseData.PluginDescriptorRef.Id, false, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -259,6 +277,7 @@ func (r *captchaProviderResource) Read(ctx context.Context, req resource.ReadReq
 
 	// Read response into the model
 	resp.Diagnostics.Append(data.readClientResponse(responseData, isImportRead)...)
+	data.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, data.Configuration, responseData.PluginDescriptorRef.Id, isImportRead, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -287,6 +306,7 @@ func (r *captchaProviderResource) Update(ctx context.Context, req resource.Updat
 
 	// Read response into the model
 	resp.Diagnostics.Append(data.readClientResponse(responseData, false)...)
+	data.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, data.Configuration, responseData.PluginDescriptorRef.Id, false, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -310,8 +330,19 @@ func (r *captchaProviderResource) Delete(ctx context.Context, req resource.Delet
 	}
 }
 
+func (r *captchaProviderResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"provider_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the plugin instance.",
+			},
+		},
+	}
+}
+
 func (r *captchaProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to provider_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("provider_id"), req, resp)
+	// Retrieve import ID or identity and save to provider_id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("provider_id"), path.Root("provider_id"), req, resp)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -29,6 +30,7 @@ import (
 var (
 	_ resource.Resource              = &certificateCAResource{}
 	_ resource.ResourceWithConfigure = &certificateCAResource{}
+	_ resource.ResourceWithIdentity  = &certificateCAResource{}
 
 	caResourceCustomId = "ca_id"
 )
@@ -347,7 +349,18 @@ func (r *certificateCAResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 }
 
+func (r *certificateCAResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"ca_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The persistent, unique ID for the certificate.",
+			},
+		},
+	}
+}
+
 func (r *certificateCAResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("ca_id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("ca_id"), path.Root("ca_id"), req, resp)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
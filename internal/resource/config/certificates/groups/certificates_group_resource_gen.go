@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -30,6 +31,7 @@ var (
 	_ resource.Resource                = &certificatesGroupResource{}
 	_ resource.ResourceWithConfigure   = &certificatesGroupResource{}
 	_ resource.ResourceWithImportState = &certificatesGroupResource{}
+	_ resource.ResourceWithIdentity    = &certificatesGroupResource{}
 
 	customId = "group_id"
 )
@@ -78,6 +80,11 @@ type certificatesGroupResourceModel struct {
 	Version                 types.Int64  `tfsdk:"version"`
 }
 
+type certificatesGroupResourceIdentityModel struct {
+	GroupName types.String `tfsdk:"group_name"`
+	GroupId   types.String `tfsdk:"group_id"`
+}
+
 func (r *certificatesGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Resource to create and manage certificates for a group.",
@@ -321,7 +328,34 @@ func (r *certificatesGroupResource) Delete(ctx context.Context, req resource.Del
 	}
 }
 
+func (r *certificatesGroupResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"group_name": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Name of the group to manage certificates for.",
+			},
+			"group_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The persistent, unique ID for the certificate.",
+			},
+		},
+	}
+}
+
 func (r *certificatesGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		// Importing by identity
+		var identity certificatesGroupResourceIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_name"), identity.GroupName)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), identity.GroupId)...)
+		return
+	}
+
 	split := strings.Split(req.ID, "/")
 	if len(split) != 2 {
 		resp.Diagnostics.AddError(providererror.InvalidResourceIdForImport, "Expected [group_name]/[group_id]. Got: "+req.ID)
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -28,6 +29,7 @@ var (
 	_ resource.Resource                = &certificatesRevocationOcspCertificateResource{}
 	_ resource.ResourceWithConfigure   = &certificatesRevocationOcspCertificateResource{}
 	_ resource.ResourceWithImportState = &certificatesRevocationOcspCertificateResource{}
+	_ resource.ResourceWithIdentity    = &certificatesRevocationOcspCertificateResource{}
 
 	customId = "certificate_id"
 )
@@ -308,7 +310,18 @@ func (r *certificatesRevocationOcspCertificateResource) Delete(ctx context.Conte
 	}
 }
 
+func (r *certificatesRevocationOcspCertificateResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"certificate_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The persistent, unique ID for the certificate.",
+			},
+		},
+	}
+}
+
 func (r *certificatesRevocationOcspCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to certificate_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("certificate_id"), req, resp)
+	// Retrieve import ID or identity and save to certificate_id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("certificate_id"), path.Root("certificate_id"), req, resp)
 }
@@ -0,0 +1,64 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package clusterreplication
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &clusterReplicationAction{}
+	_ action.ActionWithConfigure = &clusterReplicationAction{}
+)
+
+// ClusterReplicationAction is a helper function to simplify the provider implementation.
+func ClusterReplicationAction() action.Action {
+	return &clusterReplicationAction{}
+}
+
+// clusterReplicationAction is the action implementation.
+type clusterReplicationAction struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the action type name.
+func (a *clusterReplicationAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_replication"
+}
+
+func (a *clusterReplicationAction) Configure(_ context.Context, req action.ConfigureRequest, _ *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	a.providerConfig = providerCfg.ProviderConfig
+	a.apiClient = providerCfg.ApiClient
+}
+
+// Schema defines the schema for the action.
+func (a *clusterReplicationAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Action to replicate configuration changes from the administrative console node to all nodes in the cluster. " +
+			"After starting replication, the action waits until every node in the cluster reports a successful replication, up to the configured invoke timeout.",
+	}
+}
+
+func (a *clusterReplicationAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	status := replicate(ctx, a.apiClient, a.providerConfig, &resp.Diagnostics)
+	if status == nil {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Replicated the configuration to all nodes in the cluster. Node replication statuses: %s", nodeStatusSummary(status)),
+	})
+}
@@ -0,0 +1,342 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package clusterreplication
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &clusterReplicationResource{}
+	_ resource.ResourceWithConfigure   = &clusterReplicationResource{}
+	_ resource.ResourceWithImportState = &clusterReplicationResource{}
+	_ resource.ResourceWithModifyPlan  = &clusterReplicationResource{}
+
+	// Interval between checks of the cluster status while waiting for replication to complete
+	pollInterval = 5 * time.Second
+
+	nodesAttrTypes = map[string]attr.Type{
+		"address":            types.StringType,
+		"index":              types.Int64Type,
+		"mode":               types.StringType,
+		"replication_status": types.StringType,
+	}
+)
+
+// ClusterReplicationResource is a helper function to simplify the provider implementation.
+func ClusterReplicationResource() resource.Resource {
+	return &clusterReplicationResource{}
+}
+
+// clusterReplicationResource is the resource implementation.
+type clusterReplicationResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type clusterReplicationResourceModel struct {
+	LastReplicationTime      types.String `tfsdk:"last_replication_time"`
+	Nodes                    types.Set    `tfsdk:"nodes"`
+	ReplicationTriggerValues types.Map    `tfsdk:"replication_trigger_values"`
+}
+
+// Metadata returns the resource type name.
+func (r *clusterReplicationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_replication"
+}
+
+func (r *clusterReplicationResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+
+}
+
+// GetSchema defines the schema for the resource.
+func (r *clusterReplicationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to replicate configuration changes from the administrative console node to all nodes in the cluster. " +
+			"After starting replication, the resource waits until every node in the cluster reports a successful replication, up to the configured create or update timeout. " +
+			"Use `depends_on` to replicate after the resources that make configuration changes have been applied.",
+		Attributes: map[string]schema.Attribute{
+			"last_replication_time": schema.StringAttribute{
+				Description: "Time when configuration changes were last replicated.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"nodes": schema.SetNestedAttribute{
+				Description: "The nodes in the cluster, as reported after the last replication.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							Description: "The network address of the node.",
+							Computed:    true,
+						},
+						"index": schema.Int64Attribute{
+							Description: "Index of the node within the cluster, or `-1` if an index is not assigned.",
+							Computed:    true,
+						},
+						"mode": schema.StringAttribute{
+							Description: "The deployment mode of the node, from a clustering standpoint.",
+							Computed:    true,
+						},
+						"replication_status": schema.StringAttribute{
+							Description: "The replication status of the node.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"replication_trigger_values": schema.MapAttribute{
+				Description: "A meta-argument map of values that, if any values are changed, will force replication of the configuration. Adding values to and removing values from the map will not trigger a replication. This parameter can be used to replicate whenever the configuration managed by other resources changes.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Replicate the configuration via RequiresReplace when the trigger values change
+func (r *clusterReplicationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Destruction plan
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state types.Map
+	var planValues, stateValues map[string]attr.Value
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("replication_trigger_values"), &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planValues = plan.Elements()
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("replication_trigger_values"), &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateValues = state.Elements()
+
+	for k, v := range planValues {
+		if stateValue, ok := stateValues[k]; ok && (v == types.StringUnknown() || !stateValue.Equal(v)) {
+			resp.RequiresReplace = path.Paths{path.Root("replication_trigger_values")}
+			break
+		}
+	}
+}
+
+func (state *clusterReplicationResourceModel) readClientResponse(response *client.ClusterStatus) diag.Diagnostics {
+	var respDiags, diags diag.Diagnostics
+	// last_replication_time
+	if response.LastReplicationTime == nil {
+		state.LastReplicationTime = types.StringNull()
+	} else {
+		state.LastReplicationTime = types.StringValue(response.LastReplicationTime.Format(time.RFC3339))
+	}
+	// nodes
+	var nodesValues []attr.Value
+	for _, node := range response.Nodes {
+		nodeValue, diags := types.ObjectValue(nodesAttrTypes, map[string]attr.Value{
+			"address":            types.StringPointerValue(node.Address),
+			"index":              types.Int64PointerValue(node.Index),
+			"mode":               types.StringPointerValue(node.Mode),
+			"replication_status": types.StringPointerValue(node.ReplicationStatus),
+		})
+		respDiags.Append(diags...)
+		nodesValues = append(nodesValues, nodeValue)
+	}
+	state.Nodes, diags = types.SetValue(types.ObjectType{AttrTypes: nodesAttrTypes}, nodesValues)
+	respDiags.Append(diags...)
+	return respDiags
+}
+
+// Set all computed attributes to null, used when the server is not running in clustered mode
+func (state *clusterReplicationResourceModel) emptyComputedValues() {
+	state.LastReplicationTime = types.StringNull()
+	state.Nodes = types.SetNull(types.ObjectType{AttrTypes: nodesAttrTypes})
+}
+
+// Check if an error response indicates that the PingFederate server is not running in clustered mode
+func isNonClusteredModeError(httpResp *http.Response) bool {
+	if httpResp == nil {
+		return false
+	}
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return false
+	}
+	return strings.Contains(string(body), "not deployed in clustered mode")
+}
+
+// Check if the replication status reported by a node indicates success or failure
+func isSuccessfulReplicationStatus(status string) bool {
+	return strings.EqualFold(status, "SUCCEEDED") || strings.EqualFold(status, "SUCCESS")
+}
+
+func isFailedReplicationStatus(status string) bool {
+	return strings.Contains(strings.ToUpper(status), "FAIL")
+}
+
+// Get a summary of the replication status of each node, for diagnostics
+func nodeStatusSummary(response *client.ClusterStatus) string {
+	var statuses []string
+	for _, node := range response.Nodes {
+		statuses = append(statuses, fmt.Sprintf("%s: %s", node.GetAddress(), node.GetReplicationStatus()))
+	}
+	return strings.Join(statuses, ", ")
+}
+
+// Start replication and wait until every node reports that it has been successfully replicated to. Returns the
+// cluster status after replication, or nil if the server is not deployed in clustered mode or replication failed.
+func replicate(ctx context.Context, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration, diags *diag.Diagnostics) *client.ClusterStatus {
+	// Get the last replication time before starting, so that the status of this replication can be distinguished
+	// from the status of any previous replication
+	statusBefore, httpResp, err := apiClient.ClusterAPI.GetClusterStatus(config.AuthContext(ctx, providerConfig)).Execute()
+	if err != nil {
+		if isNonClusteredModeError(httpResp) {
+			diags.AddWarning(providererror.ConfigurationWarning,
+				"The PingFederate server is not deployed in clustered mode, so there is no configuration to replicate.")
+			return nil
+		}
+		config.ReportHttpError(ctx, diags, "An error occurred while reading the cluster status", err, httpResp)
+		return nil
+	}
+	lastReplicationTimeBefore := statusBefore.LastReplicationTime
+
+	_, httpResp, err = apiClient.ClusterAPI.StartReplication(config.AuthContext(ctx, providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while starting replication of the configuration", err, httpResp)
+		return nil
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		status, httpResp, err := apiClient.ClusterAPI.GetClusterStatus(config.AuthContext(ctx, providerConfig)).Execute()
+		if err != nil {
+			config.ReportHttpError(ctx, diags, "An error occurred while reading the cluster status during replication", err, httpResp)
+			return nil
+		}
+
+		replicated := status.LastReplicationTime != nil && (lastReplicationTimeBefore == nil || status.LastReplicationTime.After(*lastReplicationTimeBefore))
+		replicated = replicated && !status.GetReplicationRequired()
+		for _, node := range status.Nodes {
+			if isFailedReplicationStatus(node.GetReplicationStatus()) {
+				diags.AddError(providererror.PingFederateAPIError,
+					fmt.Sprintf("Replication of the configuration failed on node %s. Node replication statuses: %s", node.GetAddress(), nodeStatusSummary(status)))
+				return nil
+			}
+			replicated = replicated && isSuccessfulReplicationStatus(node.GetReplicationStatus())
+		}
+
+		if replicated {
+			return status
+		}
+
+		tflog.Debug(ctx, "Waiting for configuration replication to complete", map[string]interface{}{
+			"nodes": nodeStatusSummary(status),
+		})
+		select {
+		case <-ctx.Done():
+			diags.AddError(providererror.PingFederateAPIError,
+				fmt.Sprintf("Timed out waiting for replication of the configuration to complete on all nodes. Node replication statuses: %s", nodeStatusSummary(status)))
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *clusterReplicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state clusterReplicationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status := replicate(ctx, r.apiClient, r.providerConfig, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read the response into the state, maintaining the trigger values
+	if status == nil {
+		state.emptyComputedValues()
+	} else {
+		resp.Diagnostics.Append(state.readClientResponse(status)...)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *clusterReplicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state clusterReplicationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status, httpResp, err := r.apiClient.ClusterAPI.GetClusterStatus(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		if isNonClusteredModeError(httpResp) {
+			state.emptyComputedValues()
+			resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			return
+		}
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while reading the cluster status", err, httpResp)
+		return
+	}
+
+	// Read the response into the state, maintaining the trigger values
+	resp.Diagnostics.Append(state.readClientResponse(status)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *clusterReplicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// This will only happen when adding or removing replication trigger values. Just copy the plan into state.
+	resp.State.Raw = req.Plan.Raw
+}
+
+// Replication can't be undone, so Terraform can't delete it.
+func (r *clusterReplicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *clusterReplicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
+	var emptyState clusterReplicationResourceModel
+	emptyState.emptyComputedValues()
+	emptyState.ReplicationTriggerValues = types.MapNull(types.StringType)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+}
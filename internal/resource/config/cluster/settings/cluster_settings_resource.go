@@ -7,10 +7,18 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/resourcewrapper"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/version"
 )
 
+var _ resourcewrapper.ResourceWithVersionRequirements = &clusterSettingsResource{}
+
+func (r *clusterSettingsResource) VersionRequirements() []version.AttributeRequirement {
+	return []version.AttributeRequirement{
+		{Path: "replicate_log_settings_on_save", Minimum: version.PingFederate1300},
+	}
+}
+
 func (r *clusterSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	// Compare to version 13.0.0 of PF
 	compare, err := version.Compare(r.providerConfig.ProductVersion, version.PingFederate1300)
@@ -24,13 +32,6 @@ func (r *clusterSettingsResource) ModifyPlan(ctx context.Context, req resource.M
 	if plan == nil {
 		return
 	}
-	// If any of these fields are set by the user and the PF version is not new enough, throw an error
-	if !pfVersionAtLeast1300 {
-		if internaltypes.IsDefined(plan.ReplicateLogSettingsOnSave) {
-			version.AddUnsupportedAttributeError("replicate_log_settings_on_save",
-				r.providerConfig.ProductVersion, version.PingFederate1300, &resp.Diagnostics)
-		}
-	}
 
 	// Set default if version is new enough
 	if plan.ReplicateClientsOnSave.IsUnknown() {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -28,6 +29,7 @@ var (
 	_ resource.Resource                = &configStoreResource{}
 	_ resource.ResourceWithConfigure   = &configStoreResource{}
 	_ resource.ResourceWithImportState = &configStoreResource{}
+	_ resource.ResourceWithIdentity    = &configStoreResource{}
 )
 
 func ConfigStoreResource() resource.Resource {
@@ -62,6 +64,11 @@ type configStoreResourceModel struct {
 	StringValue types.String `tfsdk:"string_value"`
 }
 
+type configStoreResourceIdentityModel struct {
+	Bundle    types.String `tfsdk:"bundle"`
+	SettingId types.String `tfsdk:"setting_id"`
+}
+
 func (r *configStoreResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Resource to create and manage bundle settings.",
@@ -268,7 +275,34 @@ func (r *configStoreResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 }
 
+func (r *configStoreResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"bundle": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "This field represents a configuration file that contains a bundle of settings.",
+			},
+			"setting_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The id of the configuration setting.",
+			},
+		},
+	}
+}
+
 func (r *configStoreResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		// Importing by identity
+		var identity configStoreResourceIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bundle"), identity.Bundle)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("setting_id"), identity.SettingId)...)
+		return
+	}
+
 	split := strings.Split(req.ID, "/")
 	if len(split) != 2 {
 		resp.Diagnostics.AddError("Invalid import id for resource", "Expected [bundle]/[setting_id]. Got: "+req.ID)
@@ -0,0 +1,64 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package configurationencryptionkeysrotate
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &configurationEncryptionKeysRotateAction{}
+	_ action.ActionWithConfigure = &configurationEncryptionKeysRotateAction{}
+)
+
+// ConfigurationEncryptionKeysRotateAction is a helper function to simplify the provider implementation.
+func ConfigurationEncryptionKeysRotateAction() action.Action {
+	return &configurationEncryptionKeysRotateAction{}
+}
+
+// configurationEncryptionKeysRotateAction is the action implementation.
+type configurationEncryptionKeysRotateAction struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the action type name.
+func (a *configurationEncryptionKeysRotateAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_configuration_encryption_keys_rotate"
+}
+
+func (a *configurationEncryptionKeysRotateAction) Configure(_ context.Context, req action.ConfigureRequest, _ *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	a.providerConfig = providerCfg.ProviderConfig
+	a.apiClient = providerCfg.ApiClient
+}
+
+// Schema defines the schema for the action.
+func (a *configurationEncryptionKeysRotateAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Action to rotate the current configuration encryption keys.",
+	}
+}
+
+func (a *configurationEncryptionKeysRotateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	_, httpResp, err := a.apiClient.ConfigurationEncryptionKeysAPI.RotateConfigurationEncryptionKey(config.AuthContext(ctx, a.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while rotating the encryption keys", err, httpResp)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Rotated the configuration encryption keys",
+	})
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
@@ -24,6 +25,7 @@ import (
 var (
 	_ resource.Resource              = &connectionMetadataExportResource{}
 	_ resource.ResourceWithConfigure = &connectionMetadataExportResource{}
+	_ resource.ResourceWithIdentity  = &connectionMetadataExportResource{}
 )
 
 func ConnectionMetadataExportResource() resource.Resource {
@@ -226,3 +228,14 @@ func (r *connectionMetadataExportResource) Update(ctx context.Context, req resou
 func (r *connectionMetadataExportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// This doesn't represent a real resource in PF, so nothing to do here
 }
+
+func (r *connectionMetadataExportResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"connection_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the connection to export.",
+			},
+		},
+	}
+}
@@ -193,6 +193,22 @@ func readCustomDataStoreResponse(ctx context.Context, r *client.DataStoreAggrega
 	return diags
 }
 
+// Set the configuration fields of the custom data store that are set to their default values
+func readCustomDataStoreDefaultedFields(ctx context.Context, r *client.DataStoreAggregation, state *dataStoreModel, isImportRead bool, dsr *dataStoreResource, diags *diag.Diagnostics) {
+	if r.CustomDataStore == nil || !internaltypes.IsDefined(state.CustomDataStore) {
+		return
+	}
+	customDataStore := state.CustomDataStore.Attributes()
+	configuration, ok := customDataStore["configuration"].(types.Object)
+	if !ok {
+		return
+	}
+	customDataStore["configuration"] = pluginconfiguration.ReadDefaultedFields(ctx, configuration, r.CustomDataStore.PluginDescriptorRef.Id, isImportRead, dsr.getPluginDescriptor, diags)
+	var respDiags diag.Diagnostics
+	state.CustomDataStore, respDiags = types.ObjectValue(customDataStoreAttrType, customDataStore)
+	diags.Append(respDiags...)
+}
+
 func addOptionalCustomDataStoreFields(addRequest client.DataStoreAggregation, con context.Context, createCustomDataStore client.CustomDataStore, plan dataStoreModel) error {
 	customDataStorePlan := plan.CustomDataStore.Attributes()
 
@@ -241,6 +257,7 @@ func createCustomDataStore(plan dataStoreModel, con context.Context, req resourc
 	var state dataStoreModel
 	diags = readCustomDataStoreResponse(con, response, &state, &plan.CustomDataStore, true, false)
 	resp.Diagnostics.Append(diags...)
+	readCustomDataStoreDefaultedFields(con, response, &state, false, dsr, &resp.Diagnostics)
 	diags = resp.State.Set(con, state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -275,6 +292,7 @@ func updateCustomDataStore(plan dataStoreModel, con context.Context, req resourc
 	var state dataStoreModel
 	diags = readCustomDataStoreResponse(con, response, &state, &plan.CustomDataStore, true, false)
 	resp.Diagnostics.Append(diags...)
+	readCustomDataStoreDefaultedFields(con, response, &state, false, dsr, &resp.Diagnostics)
 
 	// Update computed values
 	diags = resp.State.Set(con, state)
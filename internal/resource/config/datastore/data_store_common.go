@@ -2,7 +2,11 @@
 
 package datastore
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
 
 type dataStoreModel struct {
 	Id                          types.String `tfsdk:"id"`
@@ -13,3 +17,11 @@ type dataStoreModel struct {
 	LdapDataStore               types.Object `tfsdk:"ldap_data_store"`
 	PingOneLdapGatewayDataStore types.Object `tfsdk:"ping_one_ldap_gateway_data_store"`
 }
+
+// Add the password attributes that are only included in the resource schema
+func resourcePasswordAttrTypes(dataSourceAttrTypes map[string]attr.Type) map[string]attr.Type {
+	attrTypes := internaltypes.AddKeyValToMapStringAttrType(dataSourceAttrTypes, "password", types.StringType)
+	attrTypes["password_wo"] = types.StringType
+	attrTypes["password_wo_version"] = types.Int64Type
+	return attrTypes
+}
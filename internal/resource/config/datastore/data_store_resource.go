@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -22,6 +23,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/pluginconfiguration"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/plugindescriptor"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/configvalidators"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
@@ -32,6 +34,7 @@ var (
 	_ resource.Resource                = &dataStoreResource{}
 	_ resource.ResourceWithConfigure   = &dataStoreResource{}
 	_ resource.ResourceWithImportState = &dataStoreResource{}
+	_ resource.ResourceWithIdentity    = &dataStoreResource{}
 
 	customId = "data_store_id"
 )
@@ -109,6 +112,11 @@ func (r *dataStoreResource) ModifyPlan(ctx context.Context, req resource.ModifyP
 		return
 	}
 
+	// Validate the configuration of custom data stores against the descriptor of the plugin type
+	if internaltypes.IsDefined(plan.CustomDataStore) {
+		pluginconfiguration.ValidateWithDescriptor(ctx, req.Config, path.Root("custom_data_store").AtName("configuration"), path.Root("custom_data_store").AtName("plugin_descriptor_ref").AtName("id"), r.getPluginDescriptor, &resp.Diagnostics)
+	}
+
 	// Build name attribute for JDBC data stores
 	if internaltypes.IsDefined(plan.JdbcDataStore) {
 		jdbcDataStore := plan.JdbcDataStore.Attributes()
@@ -152,10 +160,11 @@ func (r *dataStoreResource) ModifyPlan(ctx context.Context, req resource.ModifyP
 			jdbcDataStore["name"] = types.StringValue(nameStr.String())
 		}
 
-		// If password value has changed, mark encrypted_password value as unknown
+		// If password value or write-only password version has changed, mark encrypted_password value as unknown
 		if state != nil {
 			stateJdbcDataStore := state.JdbcDataStore.Attributes()
-			if !jdbcDataStore["password"].Equal(stateJdbcDataStore["password"]) {
+			if !jdbcDataStore["password"].Equal(stateJdbcDataStore["password"]) ||
+				!jdbcDataStore["password_wo_version"].Equal(stateJdbcDataStore["password_wo_version"]) {
 				jdbcDataStore["encrypted_password"] = types.StringUnknown()
 			}
 		}
@@ -222,10 +231,11 @@ func (r *dataStoreResource) ModifyPlan(ctx context.Context, req resource.ModifyP
 			}
 		}
 
-		// If password value has changed, mark encrypted_password value as unknown
+		// If password value or write-only password version has changed, mark encrypted_password value as unknown
 		if state != nil {
 			stateLdapDataStore := state.LdapDataStore.Attributes()
-			if !ldapDataStore["password"].Equal(stateLdapDataStore["password"]) {
+			if !ldapDataStore["password"].Equal(stateLdapDataStore["password"]) ||
+				!ldapDataStore["password_wo_version"].Equal(stateLdapDataStore["password_wo_version"]) {
 				ldapDataStore["encrypted_password"] = types.StringUnknown()
 			}
 		}
@@ -309,6 +319,14 @@ func (r *dataStoreResource) ModifyPlan(ctx context.Context, req resource.ModifyP
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
 }
 
+// Get the descriptor of the plugin type from PingFederate, for validating and reading the configuration
+func (r *dataStoreResource) getPluginDescriptor(ctx context.Context, descriptorId string) (*plugindescriptor.Descriptor, *http.Response, error) {
+	return r.providerConfig.PluginDescriptors.Get(ctx, "DataStores", descriptorId, func(ctx context.Context, descriptorId string) (*http.Response, error) {
+		_, httpResp, err := r.apiClient.DataStoresAPI.GetCustomDataStoreDescriptor(config.AuthContext(ctx, r.providerConfig), descriptorId).Execute()
+		return httpResp, err
+	})
+}
+
 func createDataStore(dataStore client.DataStoreAggregation, dsr *dataStoreResource, con context.Context, resp *resource.CreateResponse) (*client.DataStoreAggregation, *http.Response, error) {
 	apiCreateDataStore := dsr.apiClient.DataStoresAPI.CreateDataStore(config.AuthContext(con, dsr.providerConfig))
 	apiCreateDataStore = apiCreateDataStore.Body(dataStore)
@@ -367,6 +385,7 @@ func (r *dataStoreResource) Read(ctx context.Context, req resource.ReadRequest,
 	if dataStoreGetReq.CustomDataStore != nil {
 		diags = readCustomDataStoreResponse(ctx, dataStoreGetReq, &state, &state.CustomDataStore, true, isImportRead)
 		resp.Diagnostics.Append(diags...)
+		readCustomDataStoreDefaultedFields(ctx, dataStoreGetReq, &state, isImportRead, r, &resp.Diagnostics)
 	}
 
 	if dataStoreGetReq.JdbcDataStore != nil {
@@ -438,8 +457,19 @@ func (r *dataStoreResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 }
 
+func (r *dataStoreResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"data_store_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The persistent, unique ID for the data store.",
+			},
+		},
+	}
+}
+
 func (r *dataStoreResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("data_store_id"), req, resp)
+	// Retrieve import ID or identity and save to id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("data_store_id"), path.Root("data_store_id"), req, resp)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
@@ -5,6 +5,7 @@ package datastore
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -52,7 +53,7 @@ var (
 		"encrypted_password":           types.StringType,
 	}
 
-	jdbcDataStoreAttrType                = internaltypes.AddKeyValToMapStringAttrType(jdbcDataStoreDataSourceAttrType, "password", types.StringType)
+	jdbcDataStoreAttrType                = resourcePasswordAttrTypes(jdbcDataStoreDataSourceAttrType)
 	jdbcDataStoreEmptyStateObj           = types.ObjectNull(jdbcDataStoreAttrType)
 	jdbcDataStoreEmptyDataSourceStateObj = types.ObjectNull(jdbcDataStoreDataSourceAttrType)
 )
@@ -69,22 +70,41 @@ func toSchemaJdbcDataStore() schema.SingleNestedAttribute {
 			Default:     stringdefault.StaticString("JDBC"),
 		},
 		"password": schema.StringAttribute{
-			Description: "The password needed to access the database. Either this attribute or `encrypted_password` must be specified.",
+			Description: "The password needed to access the database. Exactly one of this attribute, `password_wo`, or `encrypted_password` must be specified.",
 			Optional:    true,
 			Sensitive:   true,
 			Validators: []validator.String{
 				stringvalidator.LengthAtLeast(1),
 			},
 		},
+		"password_wo": schema.StringAttribute{
+			Description: "Write-only password needed to access the database, which is never stored in Terraform state. The password is sent to PingFederate on each create and update, so change `password_wo_version` to send an updated password when no other attributes have changed. Exactly one of this attribute, `password`, or `encrypted_password` must be specified.",
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"password_wo_version": schema.Int64Attribute{
+			Description: "A version number for `password_wo`. Changing the version triggers an update that sends the current value of `password_wo` to PingFederate.",
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("password_wo")),
+			},
+		},
 		"encrypted_password": schema.StringAttribute{
-			Description: "The encrypted password needed to access the database. Either this attribute or `password` must be specified.",
+			Description: "The encrypted password needed to access the database. Exactly one of this attribute, `password`, or `password_wo` must be specified.",
 			Optional:    true,
 			Computed:    true,
 			PlanModifiers: []planmodifier.String{
 				stringplanmodifier.UseNonNullStateForUnknown(),
 			},
 			Validators: []validator.String{
-				stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("password")),
+				stringvalidator.ExactlyOneOf(
+					path.MatchRelative().AtParent().AtName("password"),
+					path.MatchRelative().AtParent().AtName("password_wo"),
+				),
 			},
 		},
 		"name": schema.StringAttribute{
@@ -327,6 +347,11 @@ func toStateJdbcDataStore(con context.Context, jdbcDataStore *client.JdbcDataSto
 		password = types.StringNull()
 	}
 
+	passwordWoVersion, ok := plan.JdbcDataStore.Attributes()["password_wo_version"].(types.Int64)
+	if !ok {
+		passwordWoVersion = types.Int64Null()
+	}
+
 	encryptedPassword := types.StringPointerValue(jdbcDataStore.EncryptedPassword)
 	if internaltypes.IsDefined(plan.JdbcDataStore.Attributes()["encrypted_password"]) {
 		encryptedPassword = types.StringValue(plan.JdbcDataStore.Attributes()["encrypted_password"].(types.String).ValueString())
@@ -351,6 +376,8 @@ func toStateJdbcDataStore(con context.Context, jdbcDataStore *client.JdbcDataSto
 	var toStateObjVal types.Object
 	if isResource {
 		jdbcAttrValue["password"] = password
+		jdbcAttrValue["password_wo"] = types.StringNull()
+		jdbcAttrValue["password_wo_version"] = passwordWoVersion
 		toStateObjVal, diags = types.ObjectValue(jdbcDataStoreAttrType, jdbcAttrValue)
 		allDiags = append(allDiags, diags...)
 	} else {
@@ -450,6 +477,11 @@ func addOptionalJdbcDataStoreFields(addRequest client.DataStoreAggregation, plan
 		addRequest.JdbcDataStore.Password = password.(types.String).ValueStringPointer()
 	}
 
+	passwordWo, ok := jdbcDataStorePlan["password_wo"]
+	if ok && internaltypes.IsDefined(passwordWo) {
+		addRequest.JdbcDataStore.Password = passwordWo.(types.String).ValueStringPointer()
+	}
+
 	encryptedPassword, ok := jdbcDataStorePlan["encrypted_password"]
 	if ok {
 		addRequest.JdbcDataStore.EncryptedPassword = encryptedPassword.(types.String).ValueStringPointer()
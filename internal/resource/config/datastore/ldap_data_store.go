@@ -5,6 +5,7 @@ package datastore
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
@@ -72,7 +73,7 @@ var (
 		"encrypted_password":         types.StringType,
 	}
 
-	ldapDataStoreAttrType                = internaltypes.AddKeyValToMapStringAttrType(ldapDataStoreEncryptedPassAttrType, "password", types.StringType)
+	ldapDataStoreAttrType                = resourcePasswordAttrTypes(ldapDataStoreEncryptedPassAttrType)
 	ldapDataStoreEmptyStateObj           = types.ObjectNull(ldapDataStoreAttrType)
 	ldapDataStoreEmptyDataSourceStateObj = types.ObjectNull(ldapDataStoreEncryptedPassAttrType)
 )
@@ -256,29 +257,48 @@ func toSchemaLdapDataStore() schema.SingleNestedAttribute {
 			Default:     int64default.StaticInt64(0),
 		},
 		"user_dn": schema.StringAttribute{
-			Description: "The username credential required to access the data store. Mutually exclusive with `bind_anonymously` and `client_tls_certificate_ref`. `password` or `encrypted_password` must also be set to use this attribute.",
+			Description: "The username credential required to access the data store. Mutually exclusive with `bind_anonymously` and `client_tls_certificate_ref`. `password`, `password_wo`, or `encrypted_password` must also be set to use this attribute.",
 			Optional:    true,
 			Validators: []validator.String{
 				stringvalidator.LengthAtLeast(1),
 			},
 		},
 		"password": schema.StringAttribute{
-			Description: "The password credential required to access the data store. Requires `user_dn` to be set. Only one of this attribute and `encrypted_password` can be set.",
+			Description: "The password credential required to access the data store. Requires `user_dn` to be set. Only one of this attribute, `password_wo`, and `encrypted_password` can be set.",
 			Optional:    true,
 			Sensitive:   true,
 			Validators: []validator.String{
 				stringvalidator.LengthAtLeast(1),
 			},
 		},
+		"password_wo": schema.StringAttribute{
+			Description: "Write-only password credential required to access the data store, which is never stored in Terraform state. The password is sent to PingFederate on each create and update, so change `password_wo_version` to send an updated password when no other attributes have changed. Requires `user_dn` to be set. Only one of this attribute, `password`, and `encrypted_password` can be set.",
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"password_wo_version": schema.Int64Attribute{
+			Description: "A version number for `password_wo`. Changing the version triggers an update that sends the current value of `password_wo` to PingFederate.",
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("password_wo")),
+			},
+		},
 		"encrypted_password": schema.StringAttribute{
-			Description: "The encrypted password credential required to access the data store. Requires `user_dn` to be set. Only one of this attribute and `password` can be set.",
+			Description: "The encrypted password credential required to access the data store. Requires `user_dn` to be set. Only one of this attribute, `password`, and `password_wo` can be set.",
 			Optional:    true,
 			Computed:    true,
 			PlanModifiers: []planmodifier.String{
 				stringplanmodifier.UseNonNullStateForUnknown(),
 			},
 			Validators: []validator.String{
-				stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("password")),
+				stringvalidator.ExactlyOneOf(
+					path.MatchRelative().AtParent().AtName("password"),
+					path.MatchRelative().AtParent().AtName("password_wo"),
+				),
 			},
 		},
 		"bind_anonymously": schema.BoolAttribute{
@@ -537,6 +557,13 @@ func toStateLdapDataStore(con context.Context, ldapDataStore *client.LdapDataSto
 		password = types.StringNull()
 	}
 
+	var passwordWoVersion types.Int64
+	if plan.Attributes()["password_wo_version"] != nil {
+		passwordWoVersion = plan.Attributes()["password_wo_version"].(types.Int64)
+	} else {
+		passwordWoVersion = types.Int64Null()
+	}
+
 	var encryptedPassword types.String
 	if internaltypes.IsDefined(plan.Attributes()["encrypted_password"]) {
 		encryptedPassword = plan.Attributes()["encrypted_password"].(types.String)
@@ -583,6 +610,8 @@ func toStateLdapDataStore(con context.Context, ldapDataStore *client.LdapDataSto
 		"time_between_evictions":     types.Int64PointerValue(ldapDataStore.TimeBetweenEvictions),
 		"type":                       types.StringValue("LDAP"),
 		"password":                   password,
+		"password_wo":                types.StringNull(),
+		"password_wo_version":        passwordWoVersion,
 		"encrypted_password":         encryptedPassword,
 		"bind_anonymously":           types.BoolPointerValue(ldapDataStore.BindAnonymously),
 		"follow_ldap_referrals":      followLdapReferrals,
@@ -691,6 +720,11 @@ func addOptionalLdapDataStoreFields(addRequest client.DataStoreAggregation, plan
 		addRequest.LdapDataStore.Password = password.(types.String).ValueStringPointer()
 	}
 
+	passwordWo, ok := ldapDataStorePlan["password_wo"]
+	if ok && internaltypes.IsDefined(passwordWo) {
+		addRequest.LdapDataStore.Password = passwordWo.(types.String).ValueStringPointer()
+	}
+
 	encryptedPassword, ok := ldapDataStorePlan["encrypted_password"]
 	if ok {
 		addRequest.LdapDataStore.EncryptedPassword = encryptedPassword.(types.String).ValueStringPointer()
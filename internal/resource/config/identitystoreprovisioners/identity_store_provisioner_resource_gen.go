@@ -6,12 +6,14 @@ package identitystoreprovisioners
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -21,6 +23,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/pluginconfiguration"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/plugindescriptor"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/configvalidators"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
@@ -30,6 +33,7 @@ var (
 	_ resource.Resource                = &identityStoreProvisionerResource{}
 	_ resource.ResourceWithConfigure   = &identityStoreProvisionerResource{}
 	_ resource.ResourceWithImportState = &identityStoreProvisionerResource{}
+	_ resource.ResourceWithIdentity    = &identityStoreProvisionerResource{}
 
 	customId = "provisioner_id"
 )
@@ -210,6 +214,11 @@ func (r *identityStoreProvisionerResource) ModifyPlan(ctx context.Context, req r
 	if plan == nil {
 		return
 	}
+
+	// Validate the configuration against the descriptor of the plugin type
+	pluginconfiguration.ValidateWithDescriptor(ctx, req.Config, path.Root("configuration"), path.Root("plugin_descriptor_ref").AtName("id"),
+		r.getPluginDescriptor, &resp.Diagnostics)
+
 	var state *identityStoreProvisionerResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if state == nil {
@@ -221,6 +230,14 @@ func (r *identityStoreProvisionerResource) ModifyPlan(ctx context.Context, req r
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
 }
 
+// Get the descriptor of the plugin type from PingFederate, for validating and reading the configuration
+func (r *identityStoreProvisionerResource) getPluginDescriptor(ctx context.Context, descriptorId string) (*plugindescriptor.Descriptor, *http.Response, error) {
+	return r.providerConfig.PluginDescriptors.Get(ctx, "IdentityStoreProvisioners", descriptorId, func(ctx context.Context, descriptorId string) (*http.Response, error) {
+		_, httpResp, err := r.apiClient.IdentityStoreProvisionersAPI.GetIdentityStoreProvisionerDescriptorById(config.AuthContext(ctx, r.providerConfig), descriptorId).Execute()
+		return httpResp, err
+	})
+}
+
 func (model *identityStoreProvisionerResourceModel) buildClientStruct() (*client.IdentityStoreProvisioner, diag.Diagnostics) {
 	result := &client.IdentityStoreProvisioner{}
 	var respDiags diag.Diagnostics
@@ -357,6 +374,7 @@ func (r *identityStoreProvisionerResource) Create(ctx context.Context, req resou
 
 	// Read response into the model
 	resp.Diagnostics.Append(data.readClientResponse(responseData, false)...)
+	data.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, data.Configuration, responseData.PluginDescriptorRef.Id, false, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -389,6 +407,7 @@ func (r *identityStoreProvisionerResource) Read(ctx context.Context, req resourc
 
 	// Read response into the model
 	resp.Diagnostics.Append(data.readClientResponse(responseData, isImportRead)...)
+	data.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, data.Configuration, responseData.PluginDescriptorRef.Id, isImportRead, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -417,6 +436,7 @@ func (r *identityStoreProvisionerResource) Update(ctx context.Context, req resou
 
 	// Read response into the model
 	resp.Diagnostics.Append(data.readClientResponse(responseData, false)...)
+	data.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, data.Configuration, responseData.PluginDescriptorRef.Id, false, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -439,8 +459,19 @@ func (r *identityStoreProvisionerResource) Delete(ctx context.Context, req resou
 	}
 }
 
+func (r *identityStoreProvisionerResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"provisioner_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the plugin instance.",
+			},
+		},
+	}
+}
+
 func (r *identityStoreProvisionerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to provisioner_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("provisioner_id"), req, resp)
+	// Retrieve import ID or identity and save to provisioner_id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("provisioner_id"), path.Root("provisioner_id"), req, resp)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
@@ -4,11 +4,13 @@ package idpadapter
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -23,6 +25,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/issuancecriteria"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/pluginconfiguration"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/plugindescriptor"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/resourcelink"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/sourcetypeidkey"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
@@ -36,6 +39,7 @@ var (
 	_ resource.Resource                = &idpAdapterResource{}
 	_ resource.ResourceWithConfigure   = &idpAdapterResource{}
 	_ resource.ResourceWithImportState = &idpAdapterResource{}
+	_ resource.ResourceWithIdentity    = &idpAdapterResource{}
 
 	customId = "adapter_id"
 )
@@ -200,6 +204,11 @@ func (r *idpAdapterResource) Schema(ctx context.Context, req resource.SchemaRequ
 						},
 						Validators: []validator.Map{
 							configvalidators.ValidAttributeContractFulfillment(),
+							attributecontractfulfillment.ValidAttributeSourceReferences(),
+							attributecontractfulfillment.ValidContractReferences("ADAPTER",
+								path.MatchRoot("attribute_contract").AtName("core_attributes"),
+								path.MatchRoot("attribute_contract").AtName("extended_attributes"),
+							),
 						},
 					},
 					"issuance_criteria": issuancecriteria.ToSchema(),
@@ -303,6 +312,10 @@ func (r *idpAdapterResource) ModifyPlan(ctx context.Context, req resource.Modify
 		return
 	}
 
+	// Validate the configuration against the descriptor of the plugin type
+	pluginconfiguration.ValidateWithDescriptor(ctx, req.Config, path.Root("configuration"), path.Root("plugin_descriptor_ref").AtName("id"),
+		r.getPluginDescriptor, &resp.Diagnostics)
+
 	// Check that any defined core and extended attributes are included in the contract fulfillment
 	if internaltypes.IsDefined(plan.AttributeContract) && internaltypes.IsDefined(plan.AttributeMapping) {
 		attributeContractAttrs := plan.AttributeContract.Attributes()
@@ -347,6 +360,14 @@ func (r *idpAdapterResource) ModifyPlan(ctx context.Context, req resource.Modify
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
 }
 
+// Get the descriptor of the plugin type from PingFederate, for validating and reading the configuration
+func (r *idpAdapterResource) getPluginDescriptor(ctx context.Context, descriptorId string) (*plugindescriptor.Descriptor, *http.Response, error) {
+	return r.providerConfig.PluginDescriptors.Get(ctx, "IdpAdapters", descriptorId, func(ctx context.Context, descriptorId string) (*http.Response, error) {
+		_, httpResp, err := r.apiClient.IdpAdaptersAPI.GetIdpAdapterDescriptorsById(config.AuthContext(ctx, r.providerConfig), descriptorId).Execute()
+		return httpResp, err
+	})
+}
+
 func (r *idpAdapterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan idpAdapterModel
 
@@ -383,6 +404,7 @@ func (r *idpAdapterResource) Create(ctx context.Context, req resource.CreateRequ
 
 	readResponseDiags := readIdpAdapterResponse(ctx, idpAdapterResponse, &state, &plan, false, true)
 	resp.Diagnostics.Append(readResponseDiags...)
+	state.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, state.Configuration, idpAdapterResponse.PluginDescriptorRef.Id, false, r.getPluginDescriptor, &resp.Diagnostics)
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -413,6 +435,7 @@ func (r *idpAdapterResource) Read(ctx context.Context, req resource.ReadRequest,
 	// Read the response into the state
 	readResponseDiags := readIdpAdapterResponse(ctx, apiReadIdpAdapter, &state, &state, isImportRead, false)
 	resp.Diagnostics.Append(readResponseDiags...)
+	state.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, state.Configuration, apiReadIdpAdapter.PluginDescriptorRef.Id, isImportRead, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -458,6 +481,7 @@ func (r *idpAdapterResource) Update(ctx context.Context, req resource.UpdateRequ
 	var state idpAdapterModel
 	readResponseDiags := readIdpAdapterResponse(ctx, updateIdpAdapterResponse, &state, &plan, false, true)
 	resp.Diagnostics.Append(readResponseDiags...)
+	state.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, state.Configuration, updateIdpAdapterResponse.PluginDescriptorRef.Id, false, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Update computed values
 	diags = resp.State.Set(ctx, state)
@@ -480,8 +504,19 @@ func (r *idpAdapterResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 }
 
+func (r *idpAdapterResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"adapter_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the plugin instance.",
+			},
+		},
+	}
+}
+
 func (r *idpAdapterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to adapter_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("adapter_id"), req, resp)
+	// Retrieve import ID or identity and save to adapter_id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("adapter_id"), path.Root("adapter_id"), req, resp)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
@@ -871,7 +871,7 @@ func (r *idpSpConnectionDataSource) Schema(ctx context.Context, req datasource.S
 											Optional:    false,
 											Description: "The fixed value that indicates how the user was authenticated.",
 										},
-										"configuration": pluginconfiguration.ToSchema(),
+										"configuration": pluginconfiguration.ToSchemaWithoutWriteOnly(),
 										"id": schema.StringAttribute{
 											Computed:    true,
 											Optional:    false,
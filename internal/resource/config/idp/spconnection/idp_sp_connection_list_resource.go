@@ -0,0 +1,40 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package idpspconnection
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/resourcewrapper"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+// IdpSpConnectionListResource is a helper function to simplify the provider implementation.
+func IdpSpConnectionListResource() list.ListResource {
+	return resourcewrapper.WrapList(IdpSpConnectionResource, listIdpSpConnections, "Limits the SP connections that are listed to those that match the filter. The filter is compared to the SP connection name and partner entity ID fields. The comparison is a case-insensitive partial match.")
+}
+
+// Get a page of the SP connections on the server
+func listIdpSpConnections(ctx context.Context, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration, listConfig resourcewrapper.ListConfig, page int64) ([]resourcewrapper.ListItem, bool, *http.Response, error) {
+	listRequest := apiClient.IdpSpConnectionsAPI.GetSpConnections(config.AuthContext(ctx, providerConfig)).Page(page).NumberPerPage(resourcewrapper.ListPageSize)
+	if internaltypes.IsDefined(listConfig.Filter) {
+		listRequest = listRequest.Filter(listConfig.Filter.ValueString())
+	}
+	responseData, httpResp, err := listRequest.Execute()
+	if err != nil {
+		return nil, false, httpResp, err
+	}
+
+	var items []resourcewrapper.ListItem
+	for _, spConnection := range responseData.Items {
+		items = append(items, resourcewrapper.ListItem{
+			Id:          spConnection.GetId(),
+			DisplayName: spConnection.Name,
+		})
+	}
+	return items, len(responseData.Items) < resourcewrapper.ListPageSize, httpResp, nil
+}
@@ -5,9 +5,11 @@ package idpspconnection
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"slices"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -15,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
@@ -50,6 +53,7 @@ var (
 	_ resource.Resource                = &idpSpConnectionResource{}
 	_ resource.ResourceWithConfigure   = &idpSpConnectionResource{}
 	_ resource.ResourceWithImportState = &idpSpConnectionResource{}
+	_ resource.ResourceWithIdentity    = &idpSpConnectionResource{}
 
 	customId = "connection_id"
 )
@@ -58,9 +62,11 @@ var (
 	resourceLinkObjectType = types.ObjectType{AttrTypes: resourcelink.AttrType()}
 
 	credentialsInboundBackChannelAuthHttpBasicCredentialsAttrTypes = map[string]attr.Type{
-		"password":           types.StringType,
-		"encrypted_password": types.StringType,
-		"username":           types.StringType,
+		"password":            types.StringType,
+		"password_wo":         types.StringType,
+		"password_wo_version": types.Int64Type,
+		"encrypted_password":  types.StringType,
+		"username":            types.StringType,
 	}
 	credentialsInboundBackChannelAuthAttrTypes = map[string]attr.Type{
 		"certs":                   types.ListType{ElemType: connectioncert.ObjType()},
@@ -71,9 +77,11 @@ var (
 		"verification_subject_dn": types.StringType,
 	}
 	credentialsOutboundBackChannelAuthHttpBasicCredentialsAttrTypes = map[string]attr.Type{
-		"password":           types.StringType,
-		"encrypted_password": types.StringType,
-		"username":           types.StringType,
+		"password":            types.StringType,
+		"password_wo":         types.StringType,
+		"password_wo_version": types.Int64Type,
+		"encrypted_password":  types.StringType,
+		"username":            types.StringType,
 	}
 
 	credentialsOutboundBackChannelAuthAttrTypes = map[string]attr.Type{
@@ -726,19 +734,40 @@ func (r *idpSpConnectionResource) Schema(ctx context.Context, req resource.Schem
 									"password": schema.StringAttribute{
 										Optional:            true,
 										Sensitive:           true,
-										Description:         "User password. Either this attribute or `encrypted_password` must be specified.",
-										MarkdownDescription: "User password. Either this attribute or `encrypted_password` must be specified.",
+										Description:         "User password. Exactly one of this attribute, `password_wo`, or `encrypted_password` must be specified.",
+										MarkdownDescription: "User password. Exactly one of this attribute, `password_wo`, or `encrypted_password` must be specified.",
 										Validators: []validator.String{
 											stringvalidator.LengthAtLeast(1),
 										},
 									},
+									"password_wo": schema.StringAttribute{
+										Optional:            true,
+										Sensitive:           true,
+										WriteOnly:           true,
+										Description:         "Write-only user password, which is never stored in Terraform state. The password is sent to PingFederate on each create and update, so change `password_wo_version` to send an updated password when no other attributes have changed. Exactly one of this attribute, `password`, or `encrypted_password` must be specified.",
+										MarkdownDescription: "Write-only user password, which is never stored in Terraform state. The password is sent to PingFederate on each create and update, so change `password_wo_version` to send an updated password when no other attributes have changed. Exactly one of this attribute, `password`, or `encrypted_password` must be specified.",
+										Validators: []validator.String{
+											stringvalidator.LengthAtLeast(1),
+										},
+									},
+									"password_wo_version": schema.Int64Attribute{
+										Optional:            true,
+										Description:         "A version number for `password_wo`. Changing the version triggers an update that sends the current value of `password_wo` to PingFederate.",
+										MarkdownDescription: "A version number for `password_wo`. Changing the version triggers an update that sends the current value of `password_wo` to PingFederate.",
+										Validators: []validator.Int64{
+											int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("password_wo")),
+										},
+									},
 									"encrypted_password": schema.StringAttribute{
 										Optional:            true,
 										Computed:            true,
-										Description:         "Encrypted user password. Either this attribute or `password` must be specified.",
-										MarkdownDescription: "Encrypted user password. Either this attribute or `password` must be specified.",
+										Description:         "Encrypted user password. Exactly one of this attribute, `password`, or `password_wo` must be specified.",
+										MarkdownDescription: "Encrypted user password. Exactly one of this attribute, `password`, or `password_wo` must be specified.",
 										Validators: []validator.String{
-											stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("password")),
+											stringvalidator.ExactlyOneOf(
+												path.MatchRelative().AtParent().AtName("password"),
+												path.MatchRelative().AtParent().AtName("password_wo"),
+											),
 										},
 									},
 								},
@@ -784,19 +813,40 @@ func (r *idpSpConnectionResource) Schema(ctx context.Context, req resource.Schem
 									"password": schema.StringAttribute{
 										Optional:            true,
 										Sensitive:           true,
-										Description:         "User password. Either this attribute or `encrypted_password` must be specified.",
-										MarkdownDescription: "User password. Either this attribute or `encrypted_password` must be specified.",
+										Description:         "User password. Exactly one of this attribute, `password_wo`, or `encrypted_password` must be specified.",
+										MarkdownDescription: "User password. Exactly one of this attribute, `password_wo`, or `encrypted_password` must be specified.",
 										Validators: []validator.String{
 											stringvalidator.LengthAtLeast(1),
 										},
 									},
+									"password_wo": schema.StringAttribute{
+										Optional:            true,
+										Sensitive:           true,
+										WriteOnly:           true,
+										Description:         "Write-only user password, which is never stored in Terraform state. The password is sent to PingFederate on each create and update, so change `password_wo_version` to send an updated password when no other attributes have changed. Exactly one of this attribute, `password`, or `encrypted_password` must be specified.",
+										MarkdownDescription: "Write-only user password, which is never stored in Terraform state. The password is sent to PingFederate on each create and update, so change `password_wo_version` to send an updated password when no other attributes have changed. Exactly one of this attribute, `password`, or `encrypted_password` must be specified.",
+										Validators: []validator.String{
+											stringvalidator.LengthAtLeast(1),
+										},
+									},
+									"password_wo_version": schema.Int64Attribute{
+										Optional:            true,
+										Description:         "A version number for `password_wo`. Changing the version triggers an update that sends the current value of `password_wo` to PingFederate.",
+										MarkdownDescription: "A version number for `password_wo`. Changing the version triggers an update that sends the current value of `password_wo` to PingFederate.",
+										Validators: []validator.Int64{
+											int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("password_wo")),
+										},
+									},
 									"encrypted_password": schema.StringAttribute{
 										Optional:            true,
 										Computed:            true,
-										Description:         "Encrypted user password. Either this attribute or `password` must be specified.",
-										MarkdownDescription: "Encrypted user password. Either this attribute or `password` must be specified.",
+										Description:         "Encrypted user password. Exactly one of this attribute, `password`, or `password_wo` must be specified.",
+										MarkdownDescription: "Encrypted user password. Exactly one of this attribute, `password`, or `password_wo` must be specified.",
 										Validators: []validator.String{
-											stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("password")),
+											stringvalidator.ExactlyOneOf(
+												path.MatchRelative().AtParent().AtName("password"),
+												path.MatchRelative().AtParent().AtName("password_wo"),
+											),
 										},
 									},
 								},
@@ -1799,6 +1849,13 @@ func (r *idpSpConnectionResource) Configure(_ context.Context, req resource.Conf
 	r.apiClient = providerCfg.ApiClient
 }
 
+// Get the attribute names of an authentication policy contract from PingFederate, for validating references to it
+func (r *idpSpConnectionResource) getPolicyContract(ctx context.Context, contractId string) (map[string]bool, *http.Response, error) {
+	return r.providerConfig.AuthenticationPolicyContracts.AttributeNames(ctx, contractId, func(ctx context.Context, contractId string) (*client.AuthenticationPolicyContract, *http.Response, error) {
+		return r.apiClient.AuthenticationPolicyContractsAPI.GetAuthenticationPolicyContract(config.AuthContext(ctx, r.providerConfig), contractId).Execute()
+	})
+}
+
 func (r *idpSpConnectionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var config *idpSpConnectionModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -1861,6 +1918,16 @@ func (r *idpSpConnectionResource) ModifyPlan(ctx context.Context, req resource.M
 		return
 	}
 
+	// Validate the references to the authentication policy contract of each assertion mapping
+	assertionMappingsPath := path.Root("sp_browser_sso").AtName("authentication_policy_contract_assertion_mappings")
+	var assertionMappings types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, assertionMappingsPath, &assertionMappings)...)
+	for i := range assertionMappings.Elements() {
+		mappingPath := assertionMappingsPath.AtListIndex(i)
+		attributecontractfulfillment.ValidatePolicyContractSources(ctx, req.Config, mappingPath.AtName("attribute_contract_fulfillment"),
+			mappingPath.AtName("authentication_policy_contract_ref").AtName("id"), r.getPolicyContract, &resp.Diagnostics)
+	}
+
 	planSpBrowserSsoAttributes := plan.SpBrowserSso.Attributes()
 	if internaltypes.IsDefined(plan.SpBrowserSso) {
 		// Get the protocol
@@ -2079,6 +2146,9 @@ func addOptionalIdpSpconnectionFields(addRequest *client.SpConnection, plan idpS
 				credentialsInboundBackChannelAuthHttpBasicCredentialsAttrs := credentialsInboundBackChannelAuthAttrs["http_basic_credentials"].(types.Object).Attributes()
 				credentialsInboundBackChannelAuthHttpBasicCredentialsValue.EncryptedPassword = credentialsInboundBackChannelAuthHttpBasicCredentialsAttrs["encrypted_password"].(types.String).ValueStringPointer()
 				credentialsInboundBackChannelAuthHttpBasicCredentialsValue.Password = credentialsInboundBackChannelAuthHttpBasicCredentialsAttrs["password"].(types.String).ValueStringPointer()
+				if internaltypes.IsDefined(credentialsInboundBackChannelAuthHttpBasicCredentialsAttrs["password_wo"]) {
+					credentialsInboundBackChannelAuthHttpBasicCredentialsValue.Password = credentialsInboundBackChannelAuthHttpBasicCredentialsAttrs["password_wo"].(types.String).ValueStringPointer()
+				}
 				credentialsInboundBackChannelAuthHttpBasicCredentialsValue.Username = credentialsInboundBackChannelAuthHttpBasicCredentialsAttrs["username"].(types.String).ValueStringPointer()
 				credentialsInboundBackChannelAuthValue.HttpBasicCredentials = credentialsInboundBackChannelAuthHttpBasicCredentialsValue
 			}
@@ -2098,6 +2168,9 @@ func addOptionalIdpSpconnectionFields(addRequest *client.SpConnection, plan idpS
 				credentialsOutboundBackChannelAuthHttpBasicCredentialsAttrs := credentialsOutboundBackChannelAuthAttrs["http_basic_credentials"].(types.Object).Attributes()
 				credentialsOutboundBackChannelAuthHttpBasicCredentialsValue.EncryptedPassword = credentialsOutboundBackChannelAuthHttpBasicCredentialsAttrs["encrypted_password"].(types.String).ValueStringPointer()
 				credentialsOutboundBackChannelAuthHttpBasicCredentialsValue.Password = credentialsOutboundBackChannelAuthHttpBasicCredentialsAttrs["password"].(types.String).ValueStringPointer()
+				if internaltypes.IsDefined(credentialsOutboundBackChannelAuthHttpBasicCredentialsAttrs["password_wo"]) {
+					credentialsOutboundBackChannelAuthHttpBasicCredentialsValue.Password = credentialsOutboundBackChannelAuthHttpBasicCredentialsAttrs["password_wo"].(types.String).ValueStringPointer()
+				}
 				credentialsOutboundBackChannelAuthHttpBasicCredentialsValue.Username = credentialsOutboundBackChannelAuthHttpBasicCredentialsAttrs["username"].(types.String).ValueStringPointer()
 				credentialsOutboundBackChannelAuthValue.HttpBasicCredentials = credentialsOutboundBackChannelAuthHttpBasicCredentialsValue
 			}
@@ -2992,6 +3065,10 @@ func (state *idpSpConnectionModel) readClientResponse(response *client.SpConnect
 					passwordFromPlan := state.Credentials.Attributes()["inbound_back_channel_auth"].(types.Object).Attributes()["http_basic_credentials"].(types.Object).Attributes()["password"].(types.String)
 					password = passwordFromPlan.ValueStringPointer()
 				}
+				passwordWoVersion := types.Int64Null()
+				if state != nil && state.Credentials.Attributes()["inbound_back_channel_auth"] != nil && state.Credentials.Attributes()["inbound_back_channel_auth"].(types.Object).Attributes()["http_basic_credentials"] != nil {
+					passwordWoVersion, _ = state.Credentials.Attributes()["inbound_back_channel_auth"].(types.Object).Attributes()["http_basic_credentials"].(types.Object).Attributes()["password_wo_version"].(types.Int64)
+				}
 				encryptedPassword := types.StringPointerValue(response.Credentials.InboundBackChannelAuth.HttpBasicCredentials.EncryptedPassword)
 				if state != nil && state.Credentials.Attributes()["inbound_back_channel_auth"] != nil && state.Credentials.Attributes()["inbound_back_channel_auth"].(types.Object).Attributes()["http_basic_credentials"] != nil {
 					encryptedPasswordFromPlan := state.Credentials.Attributes()["inbound_back_channel_auth"].(types.Object).Attributes()["http_basic_credentials"].(types.Object).Attributes()["encrypted_password"].(types.String)
@@ -3000,9 +3077,11 @@ func (state *idpSpConnectionModel) readClientResponse(response *client.SpConnect
 					}
 				}
 				credentialsInboundBackChannelAuthHttpBasicCredentialsValue, objDiags = types.ObjectValue(credentialsInboundBackChannelAuthHttpBasicCredentialsAttrTypes, map[string]attr.Value{
-					"password":           types.StringPointerValue(password),
-					"encrypted_password": encryptedPassword,
-					"username":           types.StringPointerValue(response.Credentials.InboundBackChannelAuth.HttpBasicCredentials.Username),
+					"password":            types.StringPointerValue(password),
+					"password_wo":         types.StringNull(),
+					"password_wo_version": passwordWoVersion,
+					"encrypted_password":  encryptedPassword,
+					"username":            types.StringPointerValue(response.Credentials.InboundBackChannelAuth.HttpBasicCredentials.Username),
 				})
 				respDiags.Append(objDiags...)
 			}
@@ -3029,6 +3108,10 @@ func (state *idpSpConnectionModel) readClientResponse(response *client.SpConnect
 					passwordFromPlan := state.Credentials.Attributes()["outbound_back_channel_auth"].(types.Object).Attributes()["http_basic_credentials"].(types.Object).Attributes()["password"].(types.String)
 					password = passwordFromPlan.ValueStringPointer()
 				}
+				passwordWoVersion := types.Int64Null()
+				if state != nil && state.Credentials.Attributes()["outbound_back_channel_auth"] != nil && state.Credentials.Attributes()["outbound_back_channel_auth"].(types.Object).Attributes()["http_basic_credentials"] != nil {
+					passwordWoVersion, _ = state.Credentials.Attributes()["outbound_back_channel_auth"].(types.Object).Attributes()["http_basic_credentials"].(types.Object).Attributes()["password_wo_version"].(types.Int64)
+				}
 				encryptedPassword := types.StringPointerValue(response.Credentials.OutboundBackChannelAuth.HttpBasicCredentials.EncryptedPassword)
 				if state != nil && state.Credentials.Attributes()["outbound_back_channel_auth"] != nil && state.Credentials.Attributes()["outbound_back_channel_auth"].(types.Object).Attributes()["http_basic_credentials"] != nil {
 					encryptedPasswordFromPlan := state.Credentials.Attributes()["outbound_back_channel_auth"].(types.Object).Attributes()["http_basic_credentials"].(types.Object).Attributes()["encrypted_password"].(types.String)
@@ -3037,9 +3120,11 @@ func (state *idpSpConnectionModel) readClientResponse(response *client.SpConnect
 					}
 				}
 				credentialsOutboundBackChannelAuthHttpBasicCredentialsValue, objDiags = types.ObjectValue(credentialsOutboundBackChannelAuthHttpBasicCredentialsAttrTypes, map[string]attr.Value{
-					"password":           types.StringPointerValue(password),
-					"encrypted_password": encryptedPassword,
-					"username":           types.StringPointerValue(response.Credentials.OutboundBackChannelAuth.HttpBasicCredentials.Username),
+					"password":            types.StringPointerValue(password),
+					"password_wo":         types.StringNull(),
+					"password_wo_version": passwordWoVersion,
+					"encrypted_password":  encryptedPassword,
+					"username":            types.StringPointerValue(response.Credentials.OutboundBackChannelAuth.HttpBasicCredentials.Username),
 				})
 				respDiags.Append(objDiags...)
 			}
@@ -4155,8 +4240,19 @@ func (r *idpSpConnectionResource) Delete(ctx context.Context, req resource.Delet
 	}
 }
 
+func (r *idpSpConnectionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"connection_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The persistent, unique ID for the connection.",
+			},
+		},
+	}
+}
+
 func (r *idpSpConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to connection_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("connection_id"), req, resp)
+	// Retrieve import ID or identity and save to connection_id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("connection_id"), path.Root("connection_id"), req, resp)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
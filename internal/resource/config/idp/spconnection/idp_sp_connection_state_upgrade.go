@@ -1258,10 +1258,25 @@ func (p *idpSpConnectionModel) schemaUpgradeCredentialsV0toV1(ctx context.Contex
 				inboundBackChannelAuthAttrs["certs"], respDiags = types.ListValue(connectioncert.ObjType(), finalCertValues)
 				diags.Append(respDiags...)
 			}
+			inboundBackChannelAuthAttrs["http_basic_credentials"], respDiags = schemaUpgradeHttpBasicCredentialsV0toV1(inboundBackChannelAuthAttrs["http_basic_credentials"], credentialsInboundBackChannelAuthHttpBasicCredentialsAttrTypes)
+			diags.Append(respDiags...)
 			credentialsAttrs["inbound_back_channel_auth"], respDiags = types.ObjectValue(credentialsInboundBackChannelAuthAttrTypes, inboundBackChannelAuthAttrs)
 			diags.Append(respDiags...)
 		}
 
+		// Add the write-only password attributes to the outbound_back_channel_auth credentials
+		if credentialsAttrs["outbound_back_channel_auth"].IsUnknown() {
+			credentialsAttrs["outbound_back_channel_auth"] = types.ObjectUnknown(credentialsOutboundBackChannelAuthAttrTypes)
+		} else if credentialsAttrs["outbound_back_channel_auth"].IsNull() {
+			credentialsAttrs["outbound_back_channel_auth"] = types.ObjectNull(credentialsOutboundBackChannelAuthAttrTypes)
+		} else {
+			outboundBackChannelAuthAttrs := credentialsAttrs["outbound_back_channel_auth"].(types.Object).Attributes()
+			outboundBackChannelAuthAttrs["http_basic_credentials"], respDiags = schemaUpgradeHttpBasicCredentialsV0toV1(outboundBackChannelAuthAttrs["http_basic_credentials"], credentialsOutboundBackChannelAuthHttpBasicCredentialsAttrTypes)
+			diags.Append(respDiags...)
+			credentialsAttrs["outbound_back_channel_auth"], respDiags = types.ObjectValue(credentialsOutboundBackChannelAuthAttrTypes, outboundBackChannelAuthAttrs)
+			diags.Append(respDiags...)
+		}
+
 		result, respDiags := types.ObjectValue(credentialsAttrTypes, credentialsAttrs)
 		diags.Append(respDiags...)
 
@@ -1269,6 +1284,21 @@ func (p *idpSpConnectionModel) schemaUpgradeCredentialsV0toV1(ctx context.Contex
 	}
 }
 
+// Add null write-only password attributes to http_basic_credentials, which were not present in version 0
+func schemaUpgradeHttpBasicCredentialsV0toV1(httpBasicCredentials attr.Value, attrTypes map[string]attr.Type) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if httpBasicCredentials.IsNull() {
+		return types.ObjectNull(attrTypes), diags
+	} else if httpBasicCredentials.IsUnknown() {
+		return types.ObjectUnknown(attrTypes), diags
+	}
+
+	attrs := httpBasicCredentials.(types.Object).Attributes()
+	attrs["password_wo"] = types.StringNull()
+	attrs["password_wo_version"] = types.Int64Null()
+	return types.ObjectValue(attrTypes, attrs)
+}
+
 func (p *idpSpConnectionModel) schemaUpgradeCertV0toV1(_ context.Context, certv1 types.Object) (types.Object, diag.Diagnostics) {
 	var diags, respDiags diag.Diagnostics
 	finalAttrs := map[string]attr.Value{}
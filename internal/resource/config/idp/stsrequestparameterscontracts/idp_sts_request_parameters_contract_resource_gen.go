@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -27,6 +28,7 @@ var (
 	_ resource.Resource                = &idpStsRequestParametersContractResource{}
 	_ resource.ResourceWithConfigure   = &idpStsRequestParametersContractResource{}
 	_ resource.ResourceWithImportState = &idpStsRequestParametersContractResource{}
+	_ resource.ResourceWithIdentity    = &idpStsRequestParametersContractResource{}
 
 	customId = "contract_id"
 )
@@ -230,7 +232,18 @@ func (r *idpStsRequestParametersContractResource) Delete(ctx context.Context, re
 	}
 }
 
+func (r *idpStsRequestParametersContractResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"contract_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the Security Token Service request parameter contract.",
+			},
+		},
+	}
+}
+
 func (r *idpStsRequestParametersContractResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to contract_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("contract_id"), req, resp)
+	// Retrieve import ID or identity and save to contract_id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("contract_id"), path.Root("contract_id"), req, resp)
 }
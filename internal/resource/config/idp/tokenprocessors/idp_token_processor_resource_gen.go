@@ -6,6 +6,7 @@ package idptokenprocessors
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -24,6 +26,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/pluginconfiguration"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/plugindescriptor"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 )
@@ -32,6 +35,7 @@ var (
 	_ resource.Resource                = &idpTokenProcessorResource{}
 	_ resource.ResourceWithConfigure   = &idpTokenProcessorResource{}
 	_ resource.ResourceWithImportState = &idpTokenProcessorResource{}
+	_ resource.ResourceWithIdentity    = &idpTokenProcessorResource{}
 
 	customId = "processor_id"
 )
@@ -182,6 +186,11 @@ func (r *idpTokenProcessorResource) ModifyPlan(ctx context.Context, req resource
 	if plan == nil {
 		return
 	}
+
+	// Validate the configuration against the descriptor of the plugin type
+	pluginconfiguration.ValidateWithDescriptor(ctx, req.Config, path.Root("configuration"), path.Root("plugin_descriptor_ref").AtName("id"),
+		r.getPluginDescriptor, &resp.Diagnostics)
+
 	var state *idpTokenProcessorResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if state == nil {
@@ -193,6 +202,14 @@ func (r *idpTokenProcessorResource) ModifyPlan(ctx context.Context, req resource
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
 }
 
+// Get the descriptor of the plugin type from PingFederate, for validating and reading the configuration
+func (r *idpTokenProcessorResource) getPluginDescriptor(ctx context.Context, descriptorId string) (*plugindescriptor.Descriptor, *http.Response, error) {
+	return r.providerConfig.PluginDescriptors.Get(ctx, "IdpTokenProcessors", descriptorId, func(ctx context.Context, descriptorId string) (*http.Response, error) {
+		_, httpResp, err := r.apiClient.IdpTokenProcessorsAPI.GetTokenProcessorDescriptorsById(config.AuthContext(ctx, r.providerConfig), descriptorId).Execute()
+		return httpResp, err
+	})
+}
+
 func (model *idpTokenProcessorResourceModel) buildClientStruct() (*client.TokenProcessor, diag.Diagnostics) {
 	result := &client.TokenProcessor{}
 	var respDiags diag.Diagnostics
@@ -360,6 +377,7 @@ func (r *idpTokenProcessorResource) Create(ctx context.Context, req resource.Cre
 
 	// Read response into the model
 	resp.Diagnostics.Append(data.readClientResponse(responseData, false)...)
+	data.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, data.Configuration, responseData.PluginDescriptorRef.Id, false, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -392,6 +410,7 @@ func (r *idpTokenProcessorResource) Read(ctx context.Context, req resource.ReadR
 
 	// Read response into the model
 	resp.Diagnostics.Append(data.readClientResponse(responseData, isImportRead)...)
+	data.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, data.Configuration, responseData.PluginDescriptorRef.Id, isImportRead, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -420,6 +439,7 @@ func (r *idpTokenProcessorResource) Update(ctx context.Context, req resource.Upd
 
 	// Read response into the model
 	resp.Diagnostics.Append(data.readClientResponse(responseData, false)...)
+	data.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, data.Configuration, responseData.PluginDescriptorRef.Id, false, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -442,8 +462,19 @@ func (r *idpTokenProcessorResource) Delete(ctx context.Context, req resource.Del
 	}
 }
 
+func (r *idpTokenProcessorResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"processor_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the plugin instance.",
+			},
+		},
+	}
+}
+
 func (r *idpTokenProcessorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to processor_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("processor_id"), req, resp)
+	// Retrieve import ID or identity and save to processor_id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("processor_id"), path.Root("processor_id"), req, resp)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -31,6 +32,7 @@ var (
 	_ resource.Resource                = &idpToSpAdapterMappingResource{}
 	_ resource.ResourceWithConfigure   = &idpToSpAdapterMappingResource{}
 	_ resource.ResourceWithImportState = &idpToSpAdapterMappingResource{}
+	_ resource.ResourceWithIdentity    = &idpToSpAdapterMappingResource{}
 )
 
 func IdpToSpAdapterMappingResource() resource.Resource {
@@ -310,7 +312,18 @@ func (r *idpToSpAdapterMappingResource) Delete(ctx context.Context, req resource
 	}
 }
 
+func (r *idpToSpAdapterMappingResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"mapping_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The id of the IdP-to-SP Adapter mapping.",
+			},
+		},
+	}
+}
+
 func (r *idpToSpAdapterMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to mapping_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("mapping_id"), req, resp)
+	// Retrieve import ID or identity and save to mapping_id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("mapping_id"), path.Root("mapping_id"), req, resp)
 }
@@ -4,8 +4,10 @@ package incomingproxysettings
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -15,14 +17,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/resourcewrapper"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &incomingProxySettingsResource{}
-	_ resource.ResourceWithConfigure   = &incomingProxySettingsResource{}
-	_ resource.ResourceWithImportState = &incomingProxySettingsResource{}
+	_ resource.Resource                            = &incomingProxySettingsResource{}
+	_ resource.ResourceWithConfigure               = &incomingProxySettingsResource{}
+	_ resource.ResourceWithImportState             = &incomingProxySettingsResource{}
+	_ resourcewrapper.ResourceWithRestoreOnDestroy = &incomingProxySettingsResource{}
 )
 
 // IncomingProxySettingsResource is a helper function to simplify the provider implementation.
@@ -290,6 +295,38 @@ func (r *incomingProxySettingsResource) Update(ctx context.Context, req resource
 	resp.Diagnostics.Append(diags...)
 }
 
+// ReadConfiguration returns the JSON of the incoming proxy settings currently on the server, so they can be restored on destroy
+func (r *incomingProxySettingsResource) ReadConfiguration(ctx context.Context) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	response, httpResp, err := r.apiClient.IncomingProxySettingsAPI.GetIncomingProxySettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &diags, "An error occurred while reading the incoming proxy settings to restore on destroy", err, httpResp)
+		return nil, diags
+	}
+	configuration, err := json.Marshal(response)
+	if err != nil {
+		diags.AddError(providererror.InternalProviderError, "Failed to marshal the incoming proxy settings to restore on destroy: "+err.Error())
+	}
+	return configuration, diags
+}
+
+// RestoreConfiguration puts incoming proxy settings returned by ReadConfiguration back on the server
+func (r *incomingProxySettingsResource) RestoreConfiguration(ctx context.Context, configuration []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var original client.IncomingProxySettings
+	if err := json.Unmarshal(configuration, &original); err != nil {
+		diags.AddError(providererror.InternalProviderError, "Failed to unmarshal the original incoming proxy settings from private state: "+err.Error())
+		return diags
+	}
+	apiUpdateRequest := r.apiClient.IncomingProxySettingsAPI.UpdateIncomingProxySettings(config.AuthContext(ctx, r.providerConfig))
+	apiUpdateRequest = apiUpdateRequest.Body(original)
+	_, httpResp, err := r.apiClient.IncomingProxySettingsAPI.UpdateIncomingProxySettingsExecute(apiUpdateRequest)
+	if err != nil {
+		config.ReportHttpError(ctx, &diags, "An error occurred while restoring the original incoming proxy settings", err, httpResp)
+	}
+	return diags
+}
+
 // This config object is edit-only, so Terraform can't delete it.
 func (r *incomingProxySettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 }
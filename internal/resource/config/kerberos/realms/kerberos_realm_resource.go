@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
@@ -30,6 +31,7 @@ var (
 	_ resource.Resource                = &kerberosRealmsResource{}
 	_ resource.ResourceWithConfigure   = &kerberosRealmsResource{}
 	_ resource.ResourceWithImportState = &kerberosRealmsResource{}
+	_ resource.ResourceWithIdentity    = &kerberosRealmsResource{}
 
 	emptyStringSet, _ = types.SetValue(types.StringType, nil)
 
@@ -405,7 +407,18 @@ func (r *kerberosRealmsResource) Delete(ctx context.Context, req resource.Delete
 	}
 }
 
+func (r *kerberosRealmsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"realm_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The persistent, unique ID for the Kerberos Realm.",
+			},
+		},
+	}
+}
+
 func (r *kerberosRealmsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("realm_id"), req, resp)
+	// Retrieve import ID or identity and save to id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("realm_id"), path.Root("realm_id"), req, resp)
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -30,6 +31,7 @@ var (
 	_ resource.Resource                = &keypairsOauthOpenidConnectAdditionalKeySetResource{}
 	_ resource.ResourceWithConfigure   = &keypairsOauthOpenidConnectAdditionalKeySetResource{}
 	_ resource.ResourceWithImportState = &keypairsOauthOpenidConnectAdditionalKeySetResource{}
+	_ resource.ResourceWithIdentity    = &keypairsOauthOpenidConnectAdditionalKeySetResource{}
 
 	customId = "set_id"
 )
@@ -711,7 +713,18 @@ func (r *keypairsOauthOpenidConnectAdditionalKeySetResource) Delete(ctx context.
 	}
 }
 
+func (r *keypairsOauthOpenidConnectAdditionalKeySetResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"set_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The unique ID for the key set.",
+			},
+		},
+	}
+}
+
 func (r *keypairsOauthOpenidConnectAdditionalKeySetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to set_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("set_id"), req, resp)
+	// Retrieve import ID or identity and save to set_id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("set_id"), path.Root("set_id"), req, resp)
 }
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/resourcewrapper"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/version"
 )
@@ -25,6 +26,8 @@ var (
 	_ resource.Resource                = &keypairsOauthOpenidConnectResource{}
 	_ resource.ResourceWithConfigure   = &keypairsOauthOpenidConnectResource{}
 	_ resource.ResourceWithImportState = &keypairsOauthOpenidConnectResource{}
+
+	_ resourcewrapper.ResourceWithVersionRequirements = &keypairsOauthOpenidConnectResource{}
 )
 
 func KeypairsOauthOpenidConnectResource() resource.Resource {
@@ -511,6 +514,13 @@ func (r *keypairsOauthOpenidConnectResource) Schema(ctx context.Context, req res
 	}
 }
 
+func (r *keypairsOauthOpenidConnectResource) VersionRequirements() []version.AttributeRequirement {
+	return []version.AttributeRequirement{
+		{Path: "dynamic_key_certificate_information", Minimum: version.PingFederate1230},
+		{Path: "publish_dynamic_key_x5cs", Minimum: version.PingFederate1230},
+	}
+}
+
 func (r *keypairsOauthOpenidConnectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	// Compare to version 12.3.0 of PF
 	compare, err := version.Compare(r.providerConfig.ProductVersion, version.PingFederate1230)
@@ -524,17 +534,6 @@ func (r *keypairsOauthOpenidConnectResource) ModifyPlan(ctx context.Context, req
 	if plan == nil {
 		return
 	}
-	// If any of these fields are set by the user and the PF version is not new enough, throw an error
-	if !pfVersionAtLeast1230 {
-		if internaltypes.IsDefined(plan.DynamicKeyCertificateInformation) {
-			version.AddUnsupportedAttributeError("dynamic_key_certificate_information",
-				r.providerConfig.ProductVersion, version.PingFederate1230, &resp.Diagnostics)
-		}
-		if internaltypes.IsDefined(plan.PublishDynamicKeyX5cs) {
-			version.AddUnsupportedAttributeError("publish_dynamic_key_x5cs",
-				r.providerConfig.ProductVersion, version.PingFederate1230, &resp.Diagnostics)
-		}
-	}
 	// Set default values that can't be set in schema
 	r.setConditionalDefaults(ctx, pfVersionAtLeast1230, plan, resp)
 	// Validation that may be affected by default values
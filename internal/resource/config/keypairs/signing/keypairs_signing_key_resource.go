@@ -5,14 +5,15 @@ package keypairsigning
 import (
 	"context"
 	"net/http"
-	"sync"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -22,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/movestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/configvalidators"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
@@ -32,9 +34,10 @@ import (
 var (
 	_ resource.Resource              = &keypairsSigningKeyResource{}
 	_ resource.ResourceWithConfigure = &keypairsSigningKeyResource{}
+	_ resource.ResourceWithIdentity  = &keypairsSigningKeyResource{}
+	_ resource.ResourceWithMoveState = &keypairsSigningKeyResource{}
 
-	customId    = "key_id"
-	createMutex sync.Mutex
+	customId = "key_id"
 )
 
 // KeypairsSigningKeyResource is a helper function to simplify the provider implementation.
@@ -65,6 +68,8 @@ type keypairsSigningKeyResourceModel struct {
 	Organization                     types.String `tfsdk:"organization"`
 	OrganizationUnit                 types.String `tfsdk:"organization_unit"`
 	Password                         types.String `tfsdk:"password"`
+	PasswordWo                       types.String `tfsdk:"password_wo"`
+	PasswordWoVersion                types.Int64  `tfsdk:"password_wo_version"`
 	RotationSettings                 types.Object `tfsdk:"rotation_settings"`
 	SerialNumber                     types.String `tfsdk:"serial_number"`
 	Sha1Fingerprint                  types.String `tfsdk:"sha1_fingerprint"`
@@ -118,7 +123,7 @@ func (r *keypairsSigningKeyResource) Schema(ctx context.Context, req resource.Sc
 				},
 			},
 			"password": schema.StringAttribute{
-				Description: "Password for the file. In BCFIPS mode, the password must be at least 14 characters. Must be configured if `file_data` is set, otherwise cannot be configured. This field is immutable and will trigger a replace plan if changed.",
+				Description: "Password for the file. In BCFIPS mode, the password must be at least 14 characters. Either this attribute or `password_wo` must be configured if `file_data` is set, otherwise cannot be configured. This field is immutable and will trigger a replace plan if changed.",
 				Optional:    true,
 				Sensitive:   true,
 				PlanModifiers: []planmodifier.String{
@@ -128,6 +133,26 @@ func (r *keypairsSigningKeyResource) Schema(ctx context.Context, req resource.Sc
 					stringvalidator.LengthAtLeast(1),
 				},
 			},
+			"password_wo": schema.StringAttribute{
+				Description: "Write-only password for the file, which is never stored in Terraform state. In BCFIPS mode, the password must be at least 14 characters. Either this attribute or `password` must be configured if `file_data` is set, otherwise cannot be configured. The password is only sent to PingFederate when the key pair is imported, so change `password_wo_version` to replace the key pair with a new password.",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("password")),
+				},
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Description: "A version number for `password_wo`. This field is immutable and will trigger a replace plan if changed.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("password_wo")),
+				},
+			},
 			"crypto_provider": schema.StringAttribute{
 				Description: "Cryptographic Provider. This is only applicable if Hybrid HSM mode is true. Supported values are `LOCAL` and `HSM`. This field is immutable and will trigger a replace plan if changed.",
 				Optional:    true,
@@ -338,11 +363,11 @@ func (r *keypairsSigningKeyResource) ModifyPlan(ctx context.Context, req resourc
 
 	if internaltypes.IsDefined(plan.FileData) {
 		// The key will be imported from file_data
-		if plan.Password.IsNull() {
+		if plan.Password.IsNull() && config.PasswordWo.IsNull() {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("password"),
 				providererror.InvalidAttributeConfiguration,
-				"password must be configured when file_data is set")
+				"password or password_wo must be configured when file_data is set")
 		}
 		if internaltypes.IsDefined(config.CommonName) {
 			resp.Diagnostics.AddAttributeError(
@@ -424,6 +449,12 @@ func (r *keypairsSigningKeyResource) ModifyPlan(ctx context.Context, req resourc
 				providererror.InvalidAttributeConfiguration,
 				"password cannot be configured when file_data is not set")
 		}
+		if internaltypes.IsDefined(config.PasswordWo) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("password_wo"),
+				providererror.InvalidAttributeConfiguration,
+				"password_wo cannot be configured when file_data is not set")
+		}
 		if !internaltypes.IsDefined(plan.CommonName) {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("common_name"),
@@ -507,7 +538,11 @@ func (model *keypairsSigningKeyResourceModel) buildImportClientStruct() (*client
 	// key_id
 	result.Id = model.KeyId.ValueStringPointer()
 	// password
-	result.Password = model.Password.ValueString()
+	if internaltypes.IsDefined(model.PasswordWo) {
+		result.Password = model.PasswordWo.ValueString()
+	} else {
+		result.Password = model.Password.ValueString()
+	}
 	return result, nil
 }
 
@@ -595,6 +630,15 @@ func (r *keypairsSigningKeyResource) Metadata(_ context.Context, req resource.Me
 	resp.TypeName = req.ProviderTypeName + "_keypairs_signing_key"
 }
 
+// Move the state of the removed pingfederate_key_pair_signing_import resource to this resource
+func (r *keypairsSigningKeyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromRemovedResource("pingfederate_key_pair_signing_import", map[string]string{
+			"id": "key_id",
+		}),
+	}
+}
+
 func (r *keypairsSigningKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -624,9 +668,7 @@ func (r *keypairsSigningKeyResource) Create(ctx context.Context, req resource.Cr
 		resp.Diagnostics.Append(diags...)
 		apiCreateRequest := r.apiClient.KeyPairsSigningAPI.CreateSigningKeyPair(config.AuthContext(ctx, r.providerConfig))
 		apiCreateRequest = apiCreateRequest.Body(*clientData)
-		createMutex.Lock()
 		responseData, httpResp, err = r.apiClient.KeyPairsSigningAPI.CreateSigningKeyPairExecute(apiCreateRequest)
-		createMutex.Unlock()
 		if err != nil {
 			config.ReportHttpErrorCustomId(ctx, &resp.Diagnostics, "An error occurred while generating the signing key", err, httpResp, &customId)
 			return
@@ -636,9 +678,7 @@ func (r *keypairsSigningKeyResource) Create(ctx context.Context, req resource.Cr
 		resp.Diagnostics.Append(diags...)
 		apiCreateRequest := r.apiClient.KeyPairsSigningAPI.ImportSigningKeyPair(config.AuthContext(ctx, r.providerConfig))
 		apiCreateRequest = apiCreateRequest.Body(*clientData)
-		createMutex.Lock()
 		responseData, httpResp, err = r.apiClient.KeyPairsSigningAPI.ImportSigningKeyPairExecute(apiCreateRequest)
-		createMutex.Unlock()
 		if err != nil {
 			config.ReportHttpErrorCustomId(ctx, &resp.Diagnostics, "An error occurred while importing the signing key", err, httpResp, &customId)
 			return
@@ -703,3 +743,14 @@ func (r *keypairsSigningKeyResource) Delete(ctx context.Context, req resource.De
 		config.ReportHttpErrorCustomId(ctx, &resp.Diagnostics, "An error occurred while deleting the signing key", err, httpResp, &customId)
 	}
 }
+
+func (r *keypairsSigningKeyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"key_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The persistent, unique ID for the certificate.",
+			},
+		},
+	}
+}
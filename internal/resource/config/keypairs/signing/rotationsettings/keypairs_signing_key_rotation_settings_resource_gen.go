@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -28,6 +29,7 @@ var (
 	_ resource.Resource                = &keypairsSigningKeyRotationSettingsResource{}
 	_ resource.ResourceWithConfigure   = &keypairsSigningKeyRotationSettingsResource{}
 	_ resource.ResourceWithImportState = &keypairsSigningKeyRotationSettingsResource{}
+	_ resource.ResourceWithIdentity    = &keypairsSigningKeyRotationSettingsResource{}
 )
 
 func KeypairsSigningKeyRotationSettingsResource() resource.Resource {
@@ -287,7 +289,18 @@ func (r *keypairsSigningKeyRotationSettingsResource) Delete(ctx context.Context,
 	}
 }
 
+func (r *keypairsSigningKeyRotationSettingsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"key_pair_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "ID of the key pair to retrieve its rotation settings.",
+			},
+		},
+	}
+}
+
 func (r *keypairsSigningKeyRotationSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to key_pair_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("key_pair_id"), req, resp)
+	// Retrieve import ID or identity and save to key_pair_id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("key_pair_id"), path.Root("key_pair_id"), req, resp)
 }
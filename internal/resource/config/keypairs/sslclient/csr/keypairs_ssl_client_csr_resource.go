@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -24,6 +25,7 @@ import (
 var (
 	_ resource.Resource              = &keypairsSslClientCsrResource{}
 	_ resource.ResourceWithConfigure = &keypairsSslClientCsrResource{}
+	_ resource.ResourceWithIdentity  = &keypairsSslClientCsrResource{}
 )
 
 func KeypairsSslClientCsrResource() resource.Resource {
@@ -255,3 +257,14 @@ func (r *keypairsSslClientCsrResource) Delete(ctx context.Context, req resource.
 	// There is no way to delete the imported CSR response
 	providererror.WarnConfigurationCannotBeReset("pingfederate_keypairs_ssl_client_csr_response", &resp.Diagnostics)
 }
+
+func (r *keypairsSslClientCsrResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"keypair_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The id of the key pair.",
+			},
+		},
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package keypairssslclient
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/resourcewrapper"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+// KeypairsSslClientKeyListResource is a helper function to simplify the provider implementation.
+func KeypairsSslClientKeyListResource() list.ListResource {
+	return resourcewrapper.WrapList(KeypairsSslClientKeyResource, listKeypairsSslClientKeys, "")
+}
+
+// Get the SSL client key pairs on the server
+func listKeypairsSslClientKeys(ctx context.Context, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration, listConfig resourcewrapper.ListConfig, page int64) ([]resourcewrapper.ListItem, bool, *http.Response, error) {
+	// This collection isn't paged, so every instance is returned on the first page
+	responseData, httpResp, err := apiClient.KeyPairsSslClientAPI.GetSslClientKeyPairs(config.AuthContext(ctx, providerConfig)).Execute()
+	if err != nil {
+		return nil, false, httpResp, err
+	}
+
+	var items []resourcewrapper.ListItem
+	for _, keyPair := range responseData.Items {
+		items = append(items, resourcewrapper.ListItem{
+			Id:          keyPair.GetId(),
+			DisplayName: keyPair.GetSubjectDN(),
+		})
+	}
+	return items, true, httpResp, nil
+}
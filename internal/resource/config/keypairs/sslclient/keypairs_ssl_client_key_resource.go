@@ -5,13 +5,14 @@ package keypairssslclient
 import (
 	"context"
 	"net/http"
-	"sync"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -31,9 +32,9 @@ import (
 var (
 	_ resource.Resource              = &keypairsSslClientKeyResource{}
 	_ resource.ResourceWithConfigure = &keypairsSslClientKeyResource{}
+	_ resource.ResourceWithIdentity  = &keypairsSslClientKeyResource{}
 
-	customId    = "key_id"
-	createMutex sync.Mutex
+	customId = "key_id"
 )
 
 // KeypairsSslClientKeyResource is a helper function to simplify the provider implementation.
@@ -64,6 +65,8 @@ type keypairsSslClientKeyResourceModel struct {
 	Organization                     types.String `tfsdk:"organization"`
 	OrganizationUnit                 types.String `tfsdk:"organization_unit"`
 	Password                         types.String `tfsdk:"password"`
+	PasswordWo                       types.String `tfsdk:"password_wo"`
+	PasswordWoVersion                types.Int64  `tfsdk:"password_wo_version"`
 	SerialNumber                     types.String `tfsdk:"serial_number"`
 	Sha1Fingerprint                  types.String `tfsdk:"sha1_fingerprint"`
 	Sha256Fingerprint                types.String `tfsdk:"sha256_fingerprint"`
@@ -116,7 +119,7 @@ func (r *keypairsSslClientKeyResource) Schema(ctx context.Context, req resource.
 				},
 			},
 			"password": schema.StringAttribute{
-				Description: "Password for the file. In BCFIPS mode, the password must be at least 14 characters. Must be configured if `file_data` is set, otherwise cannot be configured. This field is immutable and will trigger a replace plan if changed.",
+				Description: "Password for the file. In BCFIPS mode, the password must be at least 14 characters. Either this attribute or `password_wo` must be configured if `file_data` is set, otherwise cannot be configured. This field is immutable and will trigger a replace plan if changed.",
 				Optional:    true,
 				Sensitive:   true,
 				PlanModifiers: []planmodifier.String{
@@ -126,6 +129,26 @@ func (r *keypairsSslClientKeyResource) Schema(ctx context.Context, req resource.
 					stringvalidator.LengthAtLeast(1),
 				},
 			},
+			"password_wo": schema.StringAttribute{
+				Description: "Write-only password for the file, which is never stored in Terraform state. In BCFIPS mode, the password must be at least 14 characters. Either this attribute or `password` must be configured if `file_data` is set, otherwise cannot be configured. The password is only sent to PingFederate when the key pair is imported, so change `password_wo_version` to replace the key pair with a new password.",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("password")),
+				},
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Description: "A version number for `password_wo`. This field is immutable and will trigger a replace plan if changed.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("password_wo")),
+				},
+			},
 			"crypto_provider": schema.StringAttribute{
 				Description: "Cryptographic Provider. This is only applicable if Hybrid HSM mode is true. Supported values are `LOCAL` and `HSM`. This field is immutable and will trigger a replace plan if changed.",
 				Optional:    true,
@@ -302,11 +325,11 @@ func (r *keypairsSslClientKeyResource) ModifyPlan(ctx context.Context, req resou
 
 	if internaltypes.IsDefined(plan.FileData) {
 		// The key will be imported from file_data
-		if plan.Password.IsNull() {
+		if plan.Password.IsNull() && config.PasswordWo.IsNull() {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("password"),
 				providererror.InvalidAttributeConfiguration,
-				"password must be configured when file_data is set")
+				"password or password_wo must be configured when file_data is set")
 		}
 		if internaltypes.IsDefined(config.CommonName) {
 			resp.Diagnostics.AddAttributeError(
@@ -388,6 +411,12 @@ func (r *keypairsSslClientKeyResource) ModifyPlan(ctx context.Context, req resou
 				providererror.InvalidAttributeConfiguration,
 				"password cannot be configured when file_data is not set")
 		}
+		if internaltypes.IsDefined(config.PasswordWo) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("password_wo"),
+				providererror.InvalidAttributeConfiguration,
+				"password_wo cannot be configured when file_data is not set")
+		}
 		if !internaltypes.IsDefined(plan.CommonName) {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("common_name"),
@@ -471,7 +500,11 @@ func (model *keypairsSslClientKeyResourceModel) buildImportClientStruct() (*clie
 	// key_id
 	result.Id = model.KeyId.ValueStringPointer()
 	// password
-	result.Password = model.Password.ValueString()
+	if internaltypes.IsDefined(model.PasswordWo) {
+		result.Password = model.PasswordWo.ValueString()
+	} else {
+		result.Password = model.Password.ValueString()
+	}
 	return result, nil
 }
 
@@ -561,9 +594,7 @@ func (r *keypairsSslClientKeyResource) Create(ctx context.Context, req resource.
 		resp.Diagnostics.Append(diags...)
 		apiCreateRequest := r.apiClient.KeyPairsSslClientAPI.CreateSslClientKeyPair(config.AuthContext(ctx, r.providerConfig))
 		apiCreateRequest = apiCreateRequest.Body(*clientData)
-		createMutex.Lock()
 		responseData, httpResp, err = r.apiClient.KeyPairsSslClientAPI.CreateSslClientKeyPairExecute(apiCreateRequest)
-		createMutex.Unlock()
 		if err != nil {
 			config.ReportHttpErrorCustomId(ctx, &resp.Diagnostics, "An error occurred while generating the ssl client key", err, httpResp, &customId)
 			return
@@ -573,9 +604,7 @@ func (r *keypairsSslClientKeyResource) Create(ctx context.Context, req resource.
 		resp.Diagnostics.Append(diags...)
 		apiCreateRequest := r.apiClient.KeyPairsSslClientAPI.ImportSslClientKeyPair(config.AuthContext(ctx, r.providerConfig))
 		apiCreateRequest = apiCreateRequest.Body(*clientData)
-		createMutex.Lock()
 		responseData, httpResp, err = r.apiClient.KeyPairsSslClientAPI.ImportSslClientKeyPairExecute(apiCreateRequest)
-		createMutex.Unlock()
 		if err != nil {
 			config.ReportHttpErrorCustomId(ctx, &resp.Diagnostics, "An error occurred while importing the ssl client key", err, httpResp, &customId)
 			return
@@ -640,3 +669,14 @@ func (r *keypairsSslClientKeyResource) Delete(ctx context.Context, req resource.
 		config.ReportHttpErrorCustomId(ctx, &resp.Diagnostics, "An error occurred while deleting the ssl client key", err, httpResp, &customId)
 	}
 }
+
+func (r *keypairsSslClientKeyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"key_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The persistent, unique ID for the certificate.",
+			},
+		},
+	}
+}
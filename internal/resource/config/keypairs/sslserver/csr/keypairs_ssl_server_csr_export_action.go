@@ -0,0 +1,111 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package keypairssslservercsr
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/resourcewrapper"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+var (
+	_ action.Action              = &keypairsSslServerCsrExportAction{}
+	_ action.ActionWithConfigure = &keypairsSslServerCsrExportAction{}
+
+	_ resourcewrapper.ReadOnlyAction = &keypairsSslServerCsrExportAction{}
+)
+
+func KeypairsSslServerCsrExportAction() action.Action {
+	return &keypairsSslServerCsrExportAction{}
+}
+
+type keypairsSslServerCsrExportAction struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type keypairsSslServerCsrExportActionModel struct {
+	KeypairId  types.String `tfsdk:"keypair_id"`
+	OutputFile types.String `tfsdk:"output_file"`
+}
+
+func (a *keypairsSslServerCsrExportAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_keypairs_ssl_server_csr_export"
+}
+
+// Exporting a CSR makes no changes to PingFederate, so it is allowed in read-only mode
+func (a *keypairsSslServerCsrExportAction) ReadOnly() {}
+
+func (a *keypairsSslServerCsrExportAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	a.providerConfig = providerCfg.ProviderConfig
+	a.apiClient = providerCfg.ApiClient
+}
+
+func (a *keypairsSslServerCsrExportAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Action to export a CSR for an SSL server key pair.",
+		Attributes: map[string]schema.Attribute{
+			"keypair_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The id of the key pair.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"output_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a local file to write the exported PEM-encoded certificate signing request to. If not set, the certificate signing request is included in the output of the action.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (a *keypairsSslServerCsrExportAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data keypairsSslServerCsrExportActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	responseData, httpResp, err := a.apiClient.KeyPairsSslServerAPI.ExportSslServerCsr(config.AuthContext(ctx, a.providerConfig), data.KeypairId.ValueString()).Execute()
+	if err != nil {
+		config.ReportHttpErrorCustomId(ctx, &resp.Diagnostics, "An error occurred while generating the certificate signing request.", err, httpResp, &customId)
+		return
+	}
+
+	if !internaltypes.IsDefined(data.OutputFile) {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Exported certificate signing request for key pair %s:\n%s", data.KeypairId.ValueString(), responseData),
+		})
+		return
+	}
+
+	if err := os.WriteFile(data.OutputFile.ValueString(), []byte(responseData), 0600); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("output_file"), providererror.InvalidAttributeConfiguration,
+			fmt.Sprintf("Failed to write the certificate signing request to %s: %s", data.OutputFile.ValueString(), err.Error()))
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Exported certificate signing request for key pair %s to %s", data.KeypairId.ValueString(), data.OutputFile.ValueString()),
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -24,6 +25,7 @@ import (
 var (
 	_ resource.Resource              = &keypairsSslServerCsrExportResource{}
 	_ resource.ResourceWithConfigure = &keypairsSslServerCsrExportResource{}
+	_ resource.ResourceWithIdentity  = &keypairsSslServerCsrExportResource{}
 
 	customId = "keypair_id"
 )
@@ -172,3 +174,14 @@ func (r *keypairsSslServerCsrExportResource) Delete(ctx context.Context, req res
 	// There is no way to delete an exported CSR
 	providererror.WarnConfigurationCannotBeReset("pingfederate_keypairs_ssl_server_csr_export", &resp.Diagnostics)
 }
+
+func (r *keypairsSslServerCsrExportResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"keypair_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The id of the key pair.",
+			},
+		},
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
@@ -26,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/movestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/resourcelink"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/configvalidators"
@@ -38,6 +40,8 @@ var (
 	_ resource.Resource                = &localIdentityProfileResource{}
 	_ resource.ResourceWithConfigure   = &localIdentityProfileResource{}
 	_ resource.ResourceWithImportState = &localIdentityProfileResource{}
+	_ resource.ResourceWithIdentity    = &localIdentityProfileResource{}
+	_ resource.ResourceWithMoveState   = &localIdentityProfileResource{}
 
 	customId = "profile_id"
 )
@@ -768,6 +772,15 @@ func (r *localIdentityProfileResource) Metadata(_ context.Context, req resource.
 	resp.TypeName = req.ProviderTypeName + "_local_identity_profile"
 }
 
+// Move the state of the removed pingfederate_local_identity_identity_profile resource to this resource
+func (r *localIdentityProfileResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromRemovedResource("pingfederate_local_identity_identity_profile", map[string]string{
+			"id": "profile_id",
+		}),
+	}
+}
+
 func (r *localIdentityProfileResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -1427,7 +1440,18 @@ func (r *localIdentityProfileResource) Delete(ctx context.Context, req resource.
 
 }
 
+func (r *localIdentityProfileResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"profile_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The persistent, unique ID for the local identity profile.",
+			},
+		},
+	}
+}
+
 func (r *localIdentityProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("profile_id"), req, resp)
+	// Retrieve import ID or identity and save to id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("profile_id"), path.Root("profile_id"), req, resp)
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -31,6 +32,7 @@ var (
 	_ resource.Resource                = &metadataUrlResource{}
 	_ resource.ResourceWithConfigure   = &metadataUrlResource{}
 	_ resource.ResourceWithImportState = &metadataUrlResource{}
+	_ resource.ResourceWithIdentity    = &metadataUrlResource{}
 
 	customId = "url_id"
 )
@@ -423,8 +425,19 @@ func (r *metadataUrlResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 }
 
+func (r *metadataUrlResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"url_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The persistent, unique ID for the Metadata Url.",
+			},
+		},
+	}
+}
+
 func (r *metadataUrlResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to url_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("url_id"), req, resp)
+	// Retrieve import ID or identity and save to url_id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("url_id"), path.Root("url_id"), req, resp)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
@@ -6,12 +6,14 @@ package notificationpublishers
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -22,6 +24,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/pluginconfiguration"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/plugindescriptor"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 )
@@ -30,6 +33,7 @@ var (
 	_ resource.Resource                = &notificationPublisherResource{}
 	_ resource.ResourceWithConfigure   = &notificationPublisherResource{}
 	_ resource.ResourceWithImportState = &notificationPublisherResource{}
+	_ resource.ResourceWithIdentity    = &notificationPublisherResource{}
 
 	customId = "publisher_id"
 )
@@ -122,6 +126,11 @@ func (r *notificationPublisherResource) ModifyPlan(ctx context.Context, req reso
 	if plan == nil {
 		return
 	}
+
+	// Validate the configuration against the descriptor of the plugin type
+	pluginconfiguration.ValidateWithDescriptor(ctx, req.Config, path.Root("configuration"), path.Root("plugin_descriptor_ref").AtName("id"),
+		r.getPluginDescriptor, &resp.Diagnostics)
+
 	var state *notificationPublisherResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if state == nil {
@@ -133,6 +142,14 @@ func (r *notificationPublisherResource) ModifyPlan(ctx context.Context, req reso
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
 }
 
+// Get the descriptor of the plugin type from PingFederate, for validating and reading the configuration
+func (r *notificationPublisherResource) getPluginDescriptor(ctx context.Context, descriptorId string) (*plugindescriptor.Descriptor, *http.Response, error) {
+	return r.providerConfig.PluginDescriptors.Get(ctx, "NotificationPublishers", descriptorId, func(ctx context.Context, descriptorId string) (*http.Response, error) {
+		_, httpResp, err := r.apiClient.NotificationPublishersAPI.GetNotificationPublisherPluginDescriptor(config.AuthContext(ctx, r.providerConfig), descriptorId).Execute()
+		return httpResp, err
+	})
+}
+
 func (model *notificationPublisherResourceModel) buildClientStruct() (*client.NotificationPublisher, diag.Diagnostics) {
 	result := &client.NotificationPublisher{}
 	var respDiags diag.Diagnostics
@@ -225,6 +242,7 @@ func (r *notificationPublisherResource) Create(ctx context.Context, req resource
 
 	// Read response into the model
 	resp.Diagnostics.Append(data.readClientResponse(responseData, false)...)
+	data.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, data.Configuration, responseData.PluginDescriptorRef.Id, false, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -257,6 +275,7 @@ func (r *notificationPublisherResource) Read(ctx context.Context, req resource.R
 
 	// Read response into the model
 	resp.Diagnostics.Append(data.readClientResponse(responseData, isImportRead)...)
+	data.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, data.Configuration, responseData.PluginDescriptorRef.Id, isImportRead, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -285,6 +304,7 @@ func (r *notificationPublisherResource) Update(ctx context.Context, req resource
 
 	// Read response into the model
 	resp.Diagnostics.Append(data.readClientResponse(responseData, false)...)
+	data.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, data.Configuration, responseData.PluginDescriptorRef.Id, false, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -308,8 +328,19 @@ func (r *notificationPublisherResource) Delete(ctx context.Context, req resource
 	}
 }
 
+func (r *notificationPublisherResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"publisher_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the plugin instance.",
+			},
+		},
+	}
+}
+
 func (r *notificationPublisherResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to publisher_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("publisher_id"), req, resp)
+	// Retrieve import ID or identity and save to publisher_id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("publisher_id"), path.Root("publisher_id"), req, resp)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/movestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/resourcelink"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
@@ -21,6 +22,7 @@ var (
 	_ resource.Resource                = &notificationPublisherSettingsResource{}
 	_ resource.ResourceWithConfigure   = &notificationPublisherSettingsResource{}
 	_ resource.ResourceWithImportState = &notificationPublisherSettingsResource{}
+	_ resource.ResourceWithMoveState   = &notificationPublisherSettingsResource{}
 )
 
 // NotificationPublisherSettingsResource is a helper function to simplify the provider implementation.
@@ -59,6 +61,13 @@ func (r *notificationPublisherSettingsResource) Metadata(_ context.Context, req
 	resp.TypeName = req.ProviderTypeName + "_notification_publisher_settings"
 }
 
+// Move the state of the removed pingfederate_notification_publishers_settings resource to this resource
+func (r *notificationPublisherSettingsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromRemovedResource("pingfederate_notification_publishers_settings", nil),
+	}
+}
+
 func (r *notificationPublisherSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -0,0 +1,37 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package oauthaccesstokenmanager
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/resourcewrapper"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+// OauthAccessTokenManagerListResource is a helper function to simplify the provider implementation.
+func OauthAccessTokenManagerListResource() list.ListResource {
+	return resourcewrapper.WrapList(OauthAccessTokenManagerResource, listOauthAccessTokenManagers, "")
+}
+
+// Get the access token managers on the server
+func listOauthAccessTokenManagers(ctx context.Context, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration, listConfig resourcewrapper.ListConfig, page int64) ([]resourcewrapper.ListItem, bool, *http.Response, error) {
+	// This collection isn't paged, so every instance is returned on the first page
+	responseData, httpResp, err := apiClient.OauthAccessTokenManagersAPI.GetTokenManagers(config.AuthContext(ctx, providerConfig)).Execute()
+	if err != nil {
+		return nil, false, httpResp, err
+	}
+
+	var items []resourcewrapper.ListItem
+	for _, tokenManager := range responseData.Items {
+		items = append(items, resourcewrapper.ListItem{
+			Id:          tokenManager.Id,
+			DisplayName: tokenManager.Name,
+		})
+	}
+	return items, true, httpResp, nil
+}
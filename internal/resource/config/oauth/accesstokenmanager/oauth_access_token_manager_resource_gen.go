@@ -6,6 +6,7 @@ package oauthaccesstokenmanager
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -28,6 +30,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/pluginconfiguration"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/plugindescriptor"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/configvalidators"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
@@ -37,6 +40,7 @@ var (
 	_ resource.Resource                = &oauthAccessTokenManagerResource{}
 	_ resource.ResourceWithConfigure   = &oauthAccessTokenManagerResource{}
 	_ resource.ResourceWithImportState = &oauthAccessTokenManagerResource{}
+	_ resource.ResourceWithIdentity    = &oauthAccessTokenManagerResource{}
 )
 
 func OauthAccessTokenManagerResource() resource.Resource {
@@ -356,6 +360,10 @@ func (r *oauthAccessTokenManagerResource) ModifyPlan(ctx context.Context, req re
 		return
 	}
 
+	// Validate the configuration against the descriptor of the plugin type
+	pluginconfiguration.ValidateWithDescriptor(ctx, req.Config, path.Root("configuration"), path.Root("plugin_descriptor_ref").AtName("id"),
+		r.getPluginDescriptor, &resp.Diagnostics)
+
 	var respDiags diag.Diagnostics
 	var state *oauthAccessTokenManagerResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -367,6 +375,14 @@ func (r *oauthAccessTokenManagerResource) ModifyPlan(ctx context.Context, req re
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
 }
 
+// Get the descriptor of the plugin type from PingFederate, for validating and reading the configuration
+func (r *oauthAccessTokenManagerResource) getPluginDescriptor(ctx context.Context, descriptorId string) (*plugindescriptor.Descriptor, *http.Response, error) {
+	return r.providerConfig.PluginDescriptors.Get(ctx, "OauthAccessTokenManagers", descriptorId, func(ctx context.Context, descriptorId string) (*http.Response, error) {
+		_, httpResp, err := r.apiClient.OauthAccessTokenManagersAPI.GetTokenManagerDescriptor(config.AuthContext(ctx, r.providerConfig), descriptorId).Execute()
+		return httpResp, err
+	})
+}
+
 func (model *oauthAccessTokenManagerResourceModel) buildClientStruct() (*client.AccessTokenManager, diag.Diagnostics) {
 	result := &client.AccessTokenManager{}
 	var respDiags diag.Diagnostics
@@ -704,6 +720,7 @@ func (r *oauthAccessTokenManagerResource) Create(ctx context.Context, req resour
 
 	// Read response into the model
 	resp.Diagnostics.Append(data.readClientResponse(responseData, false)...)
+	data.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, data.Configuration, responseData.PluginDescriptorRef.Id, false, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -736,6 +753,7 @@ func (r *oauthAccessTokenManagerResource) Read(ctx context.Context, req resource
 
 	// Read response into the model
 	resp.Diagnostics.Append(data.readClientResponse(responseData, isImportRead)...)
+	data.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, data.Configuration, responseData.PluginDescriptorRef.Id, isImportRead, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -764,6 +782,7 @@ func (r *oauthAccessTokenManagerResource) Update(ctx context.Context, req resour
 
 	// Read response into the model
 	resp.Diagnostics.Append(data.readClientResponse(responseData, false)...)
+	data.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, data.Configuration, responseData.PluginDescriptorRef.Id, false, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -786,8 +805,19 @@ func (r *oauthAccessTokenManagerResource) Delete(ctx context.Context, req resour
 	}
 }
 
+func (r *oauthAccessTokenManagerResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"manager_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the plugin instance.",
+			},
+		},
+	}
+}
+
 func (r *oauthAccessTokenManagerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to manager_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("manager_id"), req, resp)
+	// Retrieve import ID or identity and save to manager_id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("manager_id"), path.Root("manager_id"), req, resp)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
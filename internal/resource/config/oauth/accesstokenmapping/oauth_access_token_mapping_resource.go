@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -34,6 +35,7 @@ var (
 	_ resource.Resource                = &oauthAccessTokenMappingResource{}
 	_ resource.ResourceWithConfigure   = &oauthAccessTokenMappingResource{}
 	_ resource.ResourceWithImportState = &oauthAccessTokenMappingResource{}
+	_ resource.ResourceWithIdentity    = &oauthAccessTokenMappingResource{}
 
 	accessTokenMappingContext = map[string]attr.Type{
 		"type":        types.StringType,
@@ -111,6 +113,9 @@ func (r *oauthAccessTokenMappingResource) Schema(ctx context.Context, req resour
 						},
 					},
 				},
+				Validators: []validator.Map{
+					attributecontractfulfillment.ValidAttributeSourceReferences(),
+				},
 			},
 			"issuance_criteria": issuancecriteria.ToSchema(),
 			"mapping_id": schema.StringAttribute{
@@ -336,7 +341,18 @@ func (r *oauthAccessTokenMappingResource) Delete(ctx context.Context, req resour
 	}
 }
 
+func (r *oauthAccessTokenMappingResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"mapping_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The id of the Access Token Mapping.",
+			},
+		},
+	}
+}
+
 func (r *oauthAccessTokenMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("mapping_id"), req, resp)
+	// Retrieve import ID or identity and save to id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("mapping_id"), path.Root("mapping_id"), req, resp)
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -29,6 +30,7 @@ var (
 	_ resource.Resource                = &oauthAuthenticationPolicyContractMappingResource{}
 	_ resource.ResourceWithConfigure   = &oauthAuthenticationPolicyContractMappingResource{}
 	_ resource.ResourceWithImportState = &oauthAuthenticationPolicyContractMappingResource{}
+	_ resource.ResourceWithIdentity    = &oauthAuthenticationPolicyContractMappingResource{}
 )
 
 func OauthAuthenticationPolicyContractMappingResource() resource.Resource {
@@ -260,7 +262,18 @@ func (r *oauthAuthenticationPolicyContractMappingResource) Delete(ctx context.Co
 	}
 }
 
+func (r *oauthAuthenticationPolicyContractMappingResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"mapping_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the authentication policy contract to persistent grant mapping.",
+			},
+		},
+	}
+}
+
 func (r *oauthAuthenticationPolicyContractMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to mapping_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("mapping_id"), req, resp)
+	// Retrieve import ID or identity and save to mapping_id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("mapping_id"), path.Root("mapping_id"), req, resp)
 }
@@ -4,12 +4,14 @@ package oauthauthserversettings
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -25,19 +27,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/movestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/resourcelink"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/scopeentry"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/configvalidators"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/resourcewrapper"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &oauthServerSettingsResource{}
-	_ resource.ResourceWithConfigure   = &oauthServerSettingsResource{}
-	_ resource.ResourceWithImportState = &oauthServerSettingsResource{}
+	_ resource.Resource                            = &oauthServerSettingsResource{}
+	_ resource.ResourceWithConfigure               = &oauthServerSettingsResource{}
+	_ resource.ResourceWithImportState             = &oauthServerSettingsResource{}
+	_ resource.ResourceWithMoveState               = &oauthServerSettingsResource{}
+	_ resourcewrapper.ResourceWithRestoreOnDestroy = &oauthServerSettingsResource{}
 
 	scopesDefault, _ = types.SetValue(types.ObjectType{AttrTypes: scopeentry.AttrTypes()}, nil)
 
@@ -816,6 +822,13 @@ func (r *oauthServerSettingsResource) Metadata(_ context.Context, req resource.M
 	resp.TypeName = req.ProviderTypeName + "_oauth_server_settings"
 }
 
+// Move the state of the removed pingfederate_oauth_auth_server_settings resource to this resource
+func (r *oauthServerSettingsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromRemovedResource("pingfederate_oauth_auth_server_settings", nil),
+	}
+}
+
 func (r *oauthServerSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -916,6 +929,38 @@ func (r *oauthServerSettingsResource) Update(ctx context.Context, req resource.U
 	resp.Diagnostics.Append(diags...)
 }
 
+// ReadConfiguration returns the JSON of the OAuth authorization server settings currently on the server, so they can be restored on destroy
+func (r *oauthServerSettingsResource) ReadConfiguration(ctx context.Context) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	response, httpResp, err := r.apiClient.OauthAuthServerSettingsAPI.GetAuthorizationServerSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &diags, "An error occurred while reading the OAuth authorization server settings to restore on destroy", err, httpResp)
+		return nil, diags
+	}
+	configuration, err := json.Marshal(response)
+	if err != nil {
+		diags.AddError(providererror.InternalProviderError, "Failed to marshal the OAuth authorization server settings to restore on destroy: "+err.Error())
+	}
+	return configuration, diags
+}
+
+// RestoreConfiguration puts OAuth authorization server settings returned by ReadConfiguration back on the server
+func (r *oauthServerSettingsResource) RestoreConfiguration(ctx context.Context, configuration []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var original client.AuthorizationServerSettings
+	if err := json.Unmarshal(configuration, &original); err != nil {
+		diags.AddError(providererror.InternalProviderError, "Failed to unmarshal the original OAuth authorization server settings from private state: "+err.Error())
+		return diags
+	}
+	apiUpdateRequest := r.apiClient.OauthAuthServerSettingsAPI.UpdateAuthorizationServerSettings(config.AuthContext(ctx, r.providerConfig))
+	apiUpdateRequest = apiUpdateRequest.Body(original)
+	_, httpResp, err := r.apiClient.OauthAuthServerSettingsAPI.UpdateAuthorizationServerSettingsExecute(apiUpdateRequest)
+	if err != nil {
+		config.ReportHttpError(ctx, &diags, "An error occurred while restoring the original OAuth authorization server settings", err, httpResp)
+	}
+	return diags
+}
+
 // This config object is edit-only, so Terraform can't delete it.
 func (r *oauthServerSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 }
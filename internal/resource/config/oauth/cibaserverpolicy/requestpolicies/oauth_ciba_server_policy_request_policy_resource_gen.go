@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
@@ -35,6 +36,7 @@ var (
 	_ resource.Resource                = &oauthCibaServerPolicyRequestPolicyResource{}
 	_ resource.ResourceWithConfigure   = &oauthCibaServerPolicyRequestPolicyResource{}
 	_ resource.ResourceWithImportState = &oauthCibaServerPolicyRequestPolicyResource{}
+	_ resource.ResourceWithIdentity    = &oauthCibaServerPolicyRequestPolicyResource{}
 
 	customId = "policy_id"
 )
@@ -559,7 +561,18 @@ func (r *oauthCibaServerPolicyRequestPolicyResource) Delete(ctx context.Context,
 	}
 }
 
+func (r *oauthCibaServerPolicyRequestPolicyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"policy_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The request policy ID. ID is unique.",
+			},
+		},
+	}
+}
+
 func (r *oauthCibaServerPolicyRequestPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to policy_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("policy_id"), req, resp)
+	// Retrieve import ID or identity and save to policy_id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("policy_id"), path.Root("policy_id"), req, resp)
 }
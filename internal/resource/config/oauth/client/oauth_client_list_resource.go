@@ -0,0 +1,40 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package oauthclient
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/resourcewrapper"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+// OauthClientListResource is a helper function to simplify the provider implementation.
+func OauthClientListResource() list.ListResource {
+	return resourcewrapper.WrapList(OauthClientResource, listOauthClients, "Limits the OAuth clients that are listed to those that match the filter. The filter is compared to the OAuth client name and ID fields. The comparison is a case-insensitive partial match.")
+}
+
+// Get a page of the OAuth clients on the server
+func listOauthClients(ctx context.Context, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration, listConfig resourcewrapper.ListConfig, page int64) ([]resourcewrapper.ListItem, bool, *http.Response, error) {
+	listRequest := apiClient.OauthClientsAPI.GetOauthClients(config.AuthContext(ctx, providerConfig)).Page(page).NumberPerPage(resourcewrapper.ListPageSize)
+	if internaltypes.IsDefined(listConfig.Filter) {
+		listRequest = listRequest.Filter(listConfig.Filter.ValueString())
+	}
+	responseData, httpResp, err := listRequest.Execute()
+	if err != nil {
+		return nil, false, httpResp, err
+	}
+
+	var items []resourcewrapper.ListItem
+	for _, oauthClient := range responseData.Items {
+		items = append(items, resourcewrapper.ListItem{
+			Id:          oauthClient.ClientId,
+			DisplayName: oauthClient.Name,
+		})
+	}
+	return items, len(responseData.Items) < resourcewrapper.ListPageSize, httpResp, nil
+}
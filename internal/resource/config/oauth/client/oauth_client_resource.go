@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
@@ -44,6 +45,7 @@ var (
 	_ resource.Resource                = &oauthClientResource{}
 	_ resource.ResourceWithConfigure   = &oauthClientResource{}
 	_ resource.ResourceWithImportState = &oauthClientResource{}
+	_ resource.ResourceWithIdentity    = &oauthClientResource{}
 )
 
 var (
@@ -55,6 +57,13 @@ var (
 	customId = "client_id"
 )
 
+// The resource model includes write-only attributes, which are not part of the data source
+type oauthClientResourceModel struct {
+	oauthClientModel
+	ClientAuthSecretWo        types.String `tfsdk:"client_auth_secret_wo"`
+	ClientAuthSecretWoVersion types.Int64  `tfsdk:"client_auth_secret_wo_version"`
+}
+
 // OauthClientResource is a helper function to simplify the provider implementation.
 func OauthClientResource() resource.Resource {
 	return &oauthClientResource{}
@@ -672,6 +681,26 @@ func (r *oauthClientResource) Schema(ctx context.Context, req resource.SchemaReq
 					objectplanmodifier.UseNonNullStateForUnknown(),
 				},
 			},
+			"client_auth_secret_wo": schema.StringAttribute{
+				Description: "Write-only client secret for Basic Authentication, which is never stored in Terraform state. When configured, this secret is sent as `client_auth.secret` on each create and update, so change `client_auth_secret_wo_version` to send an updated secret when no other attributes have changed. Cannot be set with `client_auth.secret` or `client_auth.encrypted_secret`.",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("client_auth").AtName("secret"),
+						path.MatchRoot("client_auth").AtName("encrypted_secret"),
+					),
+				},
+			},
+			"client_auth_secret_wo_version": schema.Int64Attribute{
+				Description: "A version number for `client_auth_secret_wo`. Changing the version triggers an update that sends the current value of `client_auth_secret_wo` to PingFederate.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("client_auth_secret_wo")),
+				},
+			},
 			"jwks_settings": schema.SingleNestedAttribute{
 				Description: "JSON Web Key Set Settings of the OAuth client. Required if private key JWT client authentication or signed requests is enabled.",
 				Optional:    true,
@@ -1026,7 +1055,7 @@ func (r *oauthClientResource) Configure(_ context.Context, req resource.Configur
 }
 
 func (r *oauthClientResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var model *oauthClientModel
+	var model *oauthClientResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
 	if model == nil {
 		return
@@ -1103,11 +1132,11 @@ func (r *oauthClientResource) ValidateConfig(ctx context.Context, req resource.V
 						"client_cert_issuer_dn must be defined when client_auth.type is configured to \"CERTIFICATE\".")
 				}
 			case "SECRET":
-				if clientAuthAttributes["secret"].IsNull() && clientAuthAttributes["encrypted_secret"].IsNull() {
+				if clientAuthAttributes["secret"].IsNull() && clientAuthAttributes["encrypted_secret"].IsNull() && model.ClientAuthSecretWo.IsNull() {
 					resp.Diagnostics.AddAttributeError(
 						path.Root("client_auth"),
 						providererror.InvalidAttributeConfiguration,
-						"client_auth.secret or client_auth.encrypted_secret must be defined when client_auth.type is configured to \"SECRET\".")
+						"client_auth.secret, client_auth.encrypted_secret, or client_auth_secret_wo must be defined when client_auth.type is configured to \"SECRET\".")
 				}
 			}
 		}
@@ -1254,8 +1283,8 @@ func (r *oauthClientResource) ValidateConfig(ctx context.Context, req resource.V
 }
 
 func (r *oauthClientResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-	var plan *oauthClientModel
-	var state *oauthClientModel
+	var plan *oauthClientResourceModel
+	var state *oauthClientResourceModel
 	var diags diag.Diagnostics
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -1278,7 +1307,10 @@ func (r *oauthClientResource) ModifyPlan(ctx context.Context, req resource.Modif
 	if internaltypes.IsDefined(plan.ClientAuth) && state != nil {
 		clientAuthAttrs := plan.ClientAuth.Attributes()
 		stateClientAuthAttrs := state.ClientAuth.Attributes()
-		if clientAuthAttrs["secret"].IsNull() && clientAuthAttrs["encrypted_secret"].IsUnknown() {
+		if !plan.ClientAuthSecretWoVersion.Equal(state.ClientAuthSecretWoVersion) {
+			// A new write-only secret will be sent, so the encrypted secret will change
+			clientAuthAttrs["encrypted_secret"] = types.StringUnknown()
+		} else if clientAuthAttrs["secret"].IsNull() && clientAuthAttrs["encrypted_secret"].IsUnknown() {
 			clientAuthAttrs["encrypted_secret"] = types.StringNull()
 		} else if !stateClientAuthAttrs["secret"].Equal(clientAuthAttrs["secret"]) {
 			clientAuthAttrs["encrypted_secret"] = types.StringUnknown()
@@ -1316,9 +1348,13 @@ func (r *oauthClientResource) ModifyPlan(ctx context.Context, req resource.Modif
 	}
 }
 
-func readOauthClientResponse(ctx context.Context, r *client.Client, plan, state *oauthClientModel, productVersion version.SupportedVersion, isImportRead bool) diag.Diagnostics {
+func readOauthClientResponse(ctx context.Context, r *client.Client, plan, state *oauthClientResourceModel, productVersion version.SupportedVersion, isImportRead bool) diag.Diagnostics {
 	var diags, respDiags diag.Diagnostics
-	diags = readOauthClientResponseCommon(ctx, r, state, plan, productVersion, isImportRead)
+	diags = readOauthClientResponseCommon(ctx, r, &state.oauthClientModel, &plan.oauthClientModel, productVersion, isImportRead)
+
+	// The write-only secret is never stored in state, and its version is taken from the plan
+	state.ClientAuthSecretWo = types.StringNull()
+	state.ClientAuthSecretWoVersion = plan.ClientAuthSecretWoVersion
 
 	// state.ClientAuth
 	var clientAuthToState types.Object
@@ -1416,7 +1452,7 @@ func grantTypes(grantTypesSet types.Set) []string {
 	return grantTypesSlice
 }
 
-func addOptionalOauthClientFields(addRequest *client.Client, model oauthClientModel) error {
+func addOptionalOauthClientFields(addRequest *client.Client, model oauthClientResourceModel) error {
 	addRequest.Enabled = model.Enabled.ValueBoolPointer()
 	addRequest.Description = model.Description.ValueStringPointer()
 	addRequest.LogoUrl = model.LogoUrl.ValueStringPointer()
@@ -1503,6 +1539,10 @@ func addOptionalOauthClientFields(addRequest *client.Client, model oauthClientMo
 			}
 		}
 		clientAuthValue.Secret = clientAuthAttrs["secret"].(types.String).ValueStringPointer()
+		if internaltypes.IsDefined(model.ClientAuthSecretWo) {
+			clientAuthValue.Secret = model.ClientAuthSecretWo.ValueStringPointer()
+			clientAuthValue.EncryptedSecret = nil
+		}
 		clientAuthValue.TokenEndpointAuthSigningAlgorithm = clientAuthAttrs["token_endpoint_auth_signing_algorithm"].(types.String).ValueStringPointer()
 		clientAuthValue.Type = clientAuthAttrs["type"].(types.String).ValueStringPointer()
 		addRequest.ClientAuth = clientAuthValue
@@ -1638,7 +1678,7 @@ func addOptionalOauthClientFields(addRequest *client.Client, model oauthClientMo
 }
 
 func (r *oauthClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan oauthClientModel
+	var plan oauthClientResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -1662,7 +1702,7 @@ func (r *oauthClientResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	// Read the response into the state
-	var state oauthClientModel
+	var state oauthClientResourceModel
 
 	diags = readOauthClientResponse(ctx, oauthClientResponse, &plan, &state, r.providerConfig.ProductVersion, false)
 	resp.Diagnostics.Append(diags...)
@@ -1674,7 +1714,7 @@ func (r *oauthClientResource) Read(ctx context.Context, req resource.ReadRequest
 	isImportRead, diags := importprivatestate.IsImportRead(ctx, req, resp)
 	resp.Diagnostics.Append(diags...)
 
-	var state oauthClientModel
+	var state oauthClientResourceModel
 
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -1705,7 +1745,7 @@ func (r *oauthClientResource) Read(ctx context.Context, req resource.ReadRequest
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *oauthClientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 
-	var plan oauthClientModel
+	var plan oauthClientResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -1728,7 +1768,7 @@ func (r *oauthClientResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	// Read the response
-	var state oauthClientModel
+	var state oauthClientResourceModel
 	diags = readOauthClientResponse(ctx, updateOauthClientResponse, &plan, &state, r.providerConfig.ProductVersion, false)
 	resp.Diagnostics.Append(diags...)
 
@@ -1739,7 +1779,7 @@ func (r *oauthClientResource) Update(ctx context.Context, req resource.UpdateReq
 
 func (r *oauthClientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
-	var state oauthClientModel
+	var state oauthClientResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -1751,8 +1791,19 @@ func (r *oauthClientResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 }
 
+func (r *oauthClientResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"client_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "A unique identifier the client provides to the Resource Server to identify itself. This identifier is included with every request the client makes.",
+			},
+		},
+	}
+}
+
 func (r *oauthClientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("client_id"), req, resp)
+	// Retrieve import ID or identity and save to id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("client_id"), path.Root("client_id"), req, resp)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
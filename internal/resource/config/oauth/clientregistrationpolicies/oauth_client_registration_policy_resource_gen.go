@@ -6,12 +6,14 @@ package oauthclientregistrationpolicies
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -21,6 +23,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/pluginconfiguration"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/plugindescriptor"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 )
@@ -29,6 +32,7 @@ var (
 	_ resource.Resource                = &oauthClientRegistrationPolicyResource{}
 	_ resource.ResourceWithConfigure   = &oauthClientRegistrationPolicyResource{}
 	_ resource.ResourceWithImportState = &oauthClientRegistrationPolicyResource{}
+	_ resource.ResourceWithIdentity    = &oauthClientRegistrationPolicyResource{}
 
 	customId = "policy_id"
 )
@@ -128,6 +132,11 @@ func (r *oauthClientRegistrationPolicyResource) ModifyPlan(ctx context.Context,
 	if plan == nil {
 		return
 	}
+
+	// Validate the configuration against the descriptor of the plugin type
+	pluginconfiguration.ValidateWithDescriptor(ctx, req.Config, path.Root("configuration"), path.Root("plugin_descriptor_ref").AtName("id"),
+		r.getPluginDescriptor, &resp.Diagnostics)
+
 	var state *oauthClientRegistrationPolicyResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if state == nil {
@@ -139,6 +148,14 @@ func (r *oauthClientRegistrationPolicyResource) ModifyPlan(ctx context.Context,
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
 }
 
+// Get the descriptor of the plugin type from PingFederate, for validating and reading the configuration
+func (r *oauthClientRegistrationPolicyResource) getPluginDescriptor(ctx context.Context, descriptorId string) (*plugindescriptor.Descriptor, *http.Response, error) {
+	return r.providerConfig.PluginDescriptors.Get(ctx, "OauthClientRegistrationPolicies", descriptorId, func(ctx context.Context, descriptorId string) (*http.Response, error) {
+		_, httpResp, err := r.apiClient.OauthClientRegistrationPoliciesAPI.GetDynamicClientRegistrationDescriptor(config.AuthContext(ctx, r.providerConfig), descriptorId).Execute()
+		return httpResp, err
+	})
+}
+
 func (model *oauthClientRegistrationPolicyResourceModel) buildClientStruct() (*client.ClientRegistrationPolicy, diag.Diagnostics) {
 	result := &client.ClientRegistrationPolicy{}
 	var respDiags diag.Diagnostics
@@ -231,6 +248,7 @@ func (r *oauthClientRegistrationPolicyResource) Create(ctx context.Context, req
 
 	// Read response into the model
 	resp.Diagnostics.Append(data.readClientResponse(responseData, false)...)
+	data.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, data.Configuration, responseData.PluginDescriptorRef.Id, false, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -263,6 +281,7 @@ func (r *oauthClientRegistrationPolicyResource) Read(ctx context.Context, req re
 
 	// Read response into the model
 	resp.Diagnostics.Append(data.readClientResponse(responseData, isImportRead)...)
+	data.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, data.Configuration, responseData.PluginDescriptorRef.Id, isImportRead, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -291,6 +310,7 @@ func (r *oauthClientRegistrationPolicyResource) Update(ctx context.Context, req
 
 	// Read response into the model
 	resp.Diagnostics.Append(data.readClientResponse(responseData, false)...)
+	data.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, data.Configuration, responseData.PluginDescriptorRef.Id, false, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -313,8 +333,19 @@ func (r *oauthClientRegistrationPolicyResource) Delete(ctx context.Context, req
 	}
 }
 
+func (r *oauthClientRegistrationPolicyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"policy_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the plugin instance.",
+			},
+		},
+	}
+}
+
 func (r *oauthClientRegistrationPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to policy_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("policy_id"), req, resp)
+	// Retrieve import ID or identity and save to policy_id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("policy_id"), path.Root("policy_id"), req, resp)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -29,6 +30,7 @@ var (
 	_ resource.Resource                = &oauthIdpAdapterMappingResource{}
 	_ resource.ResourceWithConfigure   = &oauthIdpAdapterMappingResource{}
 	_ resource.ResourceWithImportState = &oauthIdpAdapterMappingResource{}
+	_ resource.ResourceWithIdentity    = &oauthIdpAdapterMappingResource{}
 
 	customId = "mapping_id"
 )
@@ -262,7 +264,18 @@ func (r *oauthIdpAdapterMappingResource) Delete(ctx context.Context, req resourc
 	}
 }
 
+func (r *oauthIdpAdapterMappingResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"mapping_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the adapter mapping.",
+			},
+		},
+	}
+}
+
 func (r *oauthIdpAdapterMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to mapping_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("mapping_id"), req, resp)
+	// Retrieve import ID or identity and save to mapping_id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("mapping_id"), path.Root("mapping_id"), req, resp)
 }
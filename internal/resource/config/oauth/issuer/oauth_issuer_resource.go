@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -25,6 +26,7 @@ var (
 	_ resource.Resource                = &oauthIssuerResource{}
 	_ resource.ResourceWithConfigure   = &oauthIssuerResource{}
 	_ resource.ResourceWithImportState = &oauthIssuerResource{}
+	_ resource.ResourceWithIdentity    = &oauthIssuerResource{}
 
 	customId = "issuer_id"
 )
@@ -235,7 +237,18 @@ func (r *oauthIssuerResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 }
 
+func (r *oauthIssuerResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"issuer_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The persistent, unique ID for the virtual issuer.",
+			},
+		},
+	}
+}
+
 func (r *oauthIssuerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("issuer_id"), req, resp)
+	// Retrieve import ID or identity and save to id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("issuer_id"), path.Root("issuer_id"), req, resp)
 }
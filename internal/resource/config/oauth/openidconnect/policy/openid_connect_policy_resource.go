@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
@@ -27,6 +28,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/issuancecriteria"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/resourcelink"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/resourcewrapper"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/version"
 )
@@ -36,6 +38,9 @@ var (
 	_ resource.Resource                = &openidConnectPolicyResource{}
 	_ resource.ResourceWithConfigure   = &openidConnectPolicyResource{}
 	_ resource.ResourceWithImportState = &openidConnectPolicyResource{}
+	_ resource.ResourceWithIdentity    = &openidConnectPolicyResource{}
+
+	_ resourcewrapper.ResourceWithVersionRequirements = &openidConnectPolicyResource{}
 
 	customId = "policy_id"
 )
@@ -219,6 +224,12 @@ func (r *openidConnectPolicyResource) Schema(ctx context.Context, req resource.S
 	resp.Schema = schema
 }
 
+func (r *openidConnectPolicyResource) VersionRequirements() []version.AttributeRequirement {
+	return []version.AttributeRequirement{
+		{Path: "allow_id_token_introspection", Minimum: version.PingFederate1230},
+	}
+}
+
 func (r *openidConnectPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	// Compare to version 12.3.0 of PF
 	compare, err := version.Compare(r.providerConfig.ProductVersion, version.PingFederate1230)
@@ -234,12 +245,6 @@ func (r *openidConnectPolicyResource) ModifyPlan(ctx context.Context, req resour
 	}
 	planModified := false
 
-	if !pfVersionAtLeast1230 {
-		if internaltypes.IsDefined(plan.AllowIdTokenIntrospection) {
-			version.AddUnsupportedAttributeError("allow_id_token_introspection",
-				r.providerConfig.ProductVersion, version.PingFederate1230, &resp.Diagnostics)
-		}
-	}
 	if pfVersionAtLeast1230 && plan.AllowIdTokenIntrospection.IsUnknown() {
 		plan.AllowIdTokenIntrospection = types.BoolValue(false)
 		planModified = true
@@ -463,7 +468,18 @@ func (r *openidConnectPolicyResource) Delete(ctx context.Context, req resource.D
 	}
 }
 
+func (r *openidConnectPolicyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"policy_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The policy ID used internally.",
+			},
+		},
+	}
+}
+
 func (r *openidConnectPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to policy_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("policy_id"), req, resp)
+	// Retrieve import ID or identity and save to policy_id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("policy_id"), path.Root("policy_id"), req, resp)
 }
@@ -4,12 +4,14 @@ package oauthoutofbandauthplugins
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -22,6 +24,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/pluginconfiguration"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/plugindescriptor"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 )
@@ -30,6 +33,7 @@ var (
 	_ resource.Resource                = &oauthOutOfBandAuthPluginResource{}
 	_ resource.ResourceWithConfigure   = &oauthOutOfBandAuthPluginResource{}
 	_ resource.ResourceWithImportState = &oauthOutOfBandAuthPluginResource{}
+	_ resource.ResourceWithIdentity    = &oauthOutOfBandAuthPluginResource{}
 )
 
 func OauthOutOfBandAuthPluginResource() resource.Resource {
@@ -188,6 +192,11 @@ func (r *oauthOutOfBandAuthPluginResource) ModifyPlan(ctx context.Context, req r
 	if plan == nil {
 		return
 	}
+
+	// Validate the configuration against the descriptor of the plugin type
+	pluginconfiguration.ValidateWithDescriptor(ctx, req.Config, path.Root("configuration"), path.Root("plugin_descriptor_ref").AtName("id"),
+		r.getPluginDescriptor, &resp.Diagnostics)
+
 	var state *oauthOutOfBandAuthPluginResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if state == nil {
@@ -199,6 +208,14 @@ func (r *oauthOutOfBandAuthPluginResource) ModifyPlan(ctx context.Context, req r
 	resp.Plan.Set(ctx, plan)
 }
 
+// Get the descriptor of the plugin type from PingFederate, for validating and reading the configuration
+func (r *oauthOutOfBandAuthPluginResource) getPluginDescriptor(ctx context.Context, descriptorId string) (*plugindescriptor.Descriptor, *http.Response, error) {
+	return r.providerConfig.PluginDescriptors.Get(ctx, "OauthOutOfBandAuthPlugins", descriptorId, func(ctx context.Context, descriptorId string) (*http.Response, error) {
+		_, httpResp, err := r.apiClient.OauthOutOfBandAuthPluginsAPI.GetOOBAuthPluginDescriptor(config.AuthContext(ctx, r.providerConfig), descriptorId).Execute()
+		return httpResp, err
+	})
+}
+
 func (model *oauthOutOfBandAuthPluginResourceModel) buildClientStruct() (*client.OutOfBandAuthenticator, diag.Diagnostics) {
 	result := &client.OutOfBandAuthenticator{}
 	var respDiags diag.Diagnostics
@@ -357,6 +374,7 @@ func (r *oauthOutOfBandAuthPluginResource) Create(ctx context.Context, req resou
 
 	// Read response into the model
 	resp.Diagnostics.Append(data.readClientResponse(responseData, false)...)
+	data.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, data.Configuration, responseData.PluginDescriptorRef.Id, false, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -389,6 +407,7 @@ func (r *oauthOutOfBandAuthPluginResource) Read(ctx context.Context, req resourc
 
 	// Read response into the model
 	resp.Diagnostics.Append(data.readClientResponse(responseData, isImportRead)...)
+	data.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, data.Configuration, responseData.PluginDescriptorRef.Id, isImportRead, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -417,6 +436,7 @@ func (r *oauthOutOfBandAuthPluginResource) Update(ctx context.Context, req resou
 
 	// Read response into the model
 	resp.Diagnostics.Append(data.readClientResponse(responseData, false)...)
+	data.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, data.Configuration, responseData.PluginDescriptorRef.Id, false, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -439,8 +459,19 @@ func (r *oauthOutOfBandAuthPluginResource) Delete(ctx context.Context, req resou
 	}
 }
 
+func (r *oauthOutOfBandAuthPluginResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"plugin_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the plugin instance.",
+			},
+		},
+	}
+}
+
 func (r *oauthOutOfBandAuthPluginResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to plugin_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("plugin_id"), req, resp)
+	// Retrieve import ID or identity and save to plugin_id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("plugin_id"), path.Root("plugin_id"), req, resp)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
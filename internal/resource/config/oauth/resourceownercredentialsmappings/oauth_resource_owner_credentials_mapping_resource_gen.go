@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -28,6 +29,7 @@ var (
 	_ resource.Resource                = &oauthResourceOwnerCredentialsMappingResource{}
 	_ resource.ResourceWithConfigure   = &oauthResourceOwnerCredentialsMappingResource{}
 	_ resource.ResourceWithImportState = &oauthResourceOwnerCredentialsMappingResource{}
+	_ resource.ResourceWithIdentity    = &oauthResourceOwnerCredentialsMappingResource{}
 )
 
 func OauthResourceOwnerCredentialsMappingResource() resource.Resource {
@@ -255,7 +257,18 @@ func (r *oauthResourceOwnerCredentialsMappingResource) Delete(ctx context.Contex
 	}
 }
 
+func (r *oauthResourceOwnerCredentialsMappingResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"mapping_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the Resource Owner Credentials Mapping. Should be the ID of a password credential validator.",
+			},
+		},
+	}
+}
+
 func (r *oauthResourceOwnerCredentialsMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to mapping_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("mapping_id"), req, resp)
+	// Retrieve import ID or identity and save to mapping_id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("mapping_id"), path.Root("mapping_id"), req, resp)
 }
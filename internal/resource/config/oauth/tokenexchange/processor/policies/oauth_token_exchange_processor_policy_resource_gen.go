@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -32,6 +33,7 @@ var (
 	_ resource.Resource                = &oauthTokenExchangeProcessorPolicyResource{}
 	_ resource.ResourceWithConfigure   = &oauthTokenExchangeProcessorPolicyResource{}
 	_ resource.ResourceWithImportState = &oauthTokenExchangeProcessorPolicyResource{}
+	_ resource.ResourceWithIdentity    = &oauthTokenExchangeProcessorPolicyResource{}
 )
 
 func OauthTokenExchangeProcessorPolicyResource() resource.Resource {
@@ -473,7 +475,18 @@ func (r *oauthTokenExchangeProcessorPolicyResource) Delete(ctx context.Context,
 	}
 }
 
+func (r *oauthTokenExchangeProcessorPolicyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"policy_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The Token Exchange processor policy ID. ID is unique.",
+			},
+		},
+	}
+}
+
 func (r *oauthTokenExchangeProcessorPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to policy_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("policy_id"), req, resp)
+	// Retrieve import ID or identity and save to policy_id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("policy_id"), path.Root("policy_id"), req, resp)
 }
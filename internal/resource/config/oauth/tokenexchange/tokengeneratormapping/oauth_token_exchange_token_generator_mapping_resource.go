@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -29,6 +30,7 @@ var (
 	_ resource.Resource                = &oauthTokenExchangeTokenGeneratorMappingResource{}
 	_ resource.ResourceWithConfigure   = &oauthTokenExchangeTokenGeneratorMappingResource{}
 	_ resource.ResourceWithImportState = &oauthTokenExchangeTokenGeneratorMappingResource{}
+	_ resource.ResourceWithIdentity    = &oauthTokenExchangeTokenGeneratorMappingResource{}
 )
 
 // OauthTokenExchangeTokenGeneratorMappingResource is a helper function to simplify the provider implementation.
@@ -259,7 +261,18 @@ func (r *oauthTokenExchangeTokenGeneratorMappingResource) Delete(ctx context.Con
 	}
 }
 
+func (r *oauthTokenExchangeTokenGeneratorMappingResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"mapping_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The id of the Token Exchange Processor policy to Token Generator mapping.",
+			},
+		},
+	}
+}
+
 func (r *oauthTokenExchangeTokenGeneratorMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("mapping_id"), req, resp)
+	// Retrieve import ID or identity and save to id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("mapping_id"), path.Root("mapping_id"), req, resp)
 }
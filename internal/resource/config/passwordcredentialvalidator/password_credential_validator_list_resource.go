@@ -0,0 +1,37 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package passwordcredentialvalidator
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/resourcewrapper"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+// PasswordCredentialValidatorListResource is a helper function to simplify the provider implementation.
+func PasswordCredentialValidatorListResource() list.ListResource {
+	return resourcewrapper.WrapList(PasswordCredentialValidatorResource, listPasswordCredentialValidators, "")
+}
+
+// Get the password credential validators on the server
+func listPasswordCredentialValidators(ctx context.Context, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration, listConfig resourcewrapper.ListConfig, page int64) ([]resourcewrapper.ListItem, bool, *http.Response, error) {
+	// This collection isn't paged, so every instance is returned on the first page
+	responseData, httpResp, err := apiClient.PasswordCredentialValidatorsAPI.GetPasswordCredentialValidators(config.AuthContext(ctx, providerConfig)).Execute()
+	if err != nil {
+		return nil, false, httpResp, err
+	}
+
+	var items []resourcewrapper.ListItem
+	for _, validator := range responseData.Items {
+		items = append(items, resourcewrapper.ListItem{
+			Id:          validator.Id,
+			DisplayName: validator.Name,
+		})
+	}
+	return items, true, httpResp, nil
+}
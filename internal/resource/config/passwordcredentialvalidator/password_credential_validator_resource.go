@@ -5,11 +5,13 @@ package passwordcredentialvalidator
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
@@ -20,6 +22,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/pluginconfiguration"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/plugindescriptor"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/resourcelink"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
@@ -31,6 +34,7 @@ var (
 	_ resource.Resource                = &passwordCredentialValidatorResource{}
 	_ resource.ResourceWithConfigure   = &passwordCredentialValidatorResource{}
 	_ resource.ResourceWithImportState = &passwordCredentialValidatorResource{}
+	_ resource.ResourceWithIdentity    = &passwordCredentialValidatorResource{}
 
 	customId = "validator_id"
 )
@@ -208,6 +212,18 @@ func (r *passwordCredentialValidatorResource) ValidateConfig(ctx context.Context
 								confirmPasswordFound = true
 							}
 						}
+						for _, attrName := range []string{"field_values", "sensitive_field_values"} {
+							if fieldsMap, ok := rowAttrs[attrName].(types.Map); ok {
+								anyUnknownNames = anyUnknownNames || fieldsMap.IsUnknown()
+								fieldValues := fieldsMap.Elements()
+								_, hasUsername := fieldValues["Username"]
+								_, hasPassword := fieldValues["Password"]
+								_, hasConfirmPassword := fieldValues["Confirm Password"]
+								usernameFound = usernameFound || hasUsername
+								passwordFound = passwordFound || hasPassword
+								confirmPasswordFound = confirmPasswordFound || hasConfirmPassword
+							}
+						}
 						if !usernameFound && !anyUnknownNames {
 							resp.Diagnostics.AddAttributeError(
 								path.Root("configuration").AtMapKey("tables"),
@@ -267,6 +283,14 @@ func (r *passwordCredentialValidatorResource) ValidateConfig(ctx context.Context
 			fieldName := field["name"].(types.String).ValueString()
 			fieldNameMap[fieldName] = true
 		}
+		for _, attrName := range []string{"field_values", "sensitive_field_values", "sensitive_fields_wo"} {
+			if fieldsMap, ok := configuration[attrName].(types.Map); ok {
+				anyUnknowns = anyUnknowns || fieldsMap.IsUnknown()
+				for fieldName := range fieldsMap.Elements() {
+					fieldNameMap[fieldName] = true
+				}
+			}
+		}
 
 		if !anyUnknowns {
 			switch pluginDescriptorRefId {
@@ -321,7 +345,15 @@ func (r *passwordCredentialValidatorResource) ModifyPlan(ctx context.Context, re
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	var respDiags diag.Diagnostics
 
-	if plan == nil || state == nil {
+	if plan == nil {
+		return
+	}
+
+	// Validate the configuration against the descriptor of the plugin type
+	pluginconfiguration.ValidateWithDescriptor(ctx, req.Config, path.Root("configuration"), path.Root("plugin_descriptor_ref").AtName("id"),
+		r.getPluginDescriptor, &resp.Diagnostics)
+
+	if state == nil {
 		return
 	}
 
@@ -331,6 +363,14 @@ func (r *passwordCredentialValidatorResource) ModifyPlan(ctx context.Context, re
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
 }
 
+// Get the descriptor of the plugin type from PingFederate, for validating and reading the configuration
+func (r *passwordCredentialValidatorResource) getPluginDescriptor(ctx context.Context, descriptorId string) (*plugindescriptor.Descriptor, *http.Response, error) {
+	return r.providerConfig.PluginDescriptors.Get(ctx, "PasswordCredentialValidators", descriptorId, func(ctx context.Context, descriptorId string) (*http.Response, error) {
+		_, httpResp, err := r.apiClient.PasswordCredentialValidatorsAPI.GetPasswordCredentialValidatorDescriptor(config.AuthContext(ctx, r.providerConfig), descriptorId).Execute()
+		return httpResp, err
+	})
+}
+
 func addOptionalPasswordCredentialValidatorFields(addRequest *client.PasswordCredentialValidator, model passwordCredentialValidatorModel) {
 	// attribute_contract
 	if !model.AttributeContract.IsNull() && !model.AttributeContract.IsUnknown() {
@@ -402,6 +442,7 @@ func (r *passwordCredentialValidatorResource) Create(ctx context.Context, req re
 
 	diags = readPasswordCredentialValidatorResponse(ctx, passwordCredentialValidatorsResponse, &state, plan.Configuration, true, false)
 	resp.Diagnostics.Append(diags...)
+	state.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, state.Configuration, passwordCredentialValidatorsResponse.PluginDescriptorRef.Id, false, r.getPluginDescriptor, &resp.Diagnostics)
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -431,6 +472,7 @@ func (r *passwordCredentialValidatorResource) Read(ctx context.Context, req reso
 	// Read the response into the state
 	diags = readPasswordCredentialValidatorResponse(ctx, apiReadPasswordCredentialValidators, &state, state.Configuration, true, isImportRead)
 	resp.Diagnostics.Append(diags...)
+	state.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, state.Configuration, apiReadPasswordCredentialValidators.PluginDescriptorRef.Id, isImportRead, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -470,6 +512,7 @@ func (r *passwordCredentialValidatorResource) Update(ctx context.Context, req re
 	// Read the response
 	diags = readPasswordCredentialValidatorResponse(ctx, updatePasswordCredentialValidatorsResponse, &plan, plan.Configuration, true, false)
 	resp.Diagnostics.Append(diags...)
+	plan.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, plan.Configuration, updatePasswordCredentialValidatorsResponse.PluginDescriptorRef.Id, false, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Update computed values
 	diags = resp.State.Set(ctx, plan)
@@ -490,8 +533,19 @@ func (r *passwordCredentialValidatorResource) Delete(ctx context.Context, req re
 	}
 }
 
+func (r *passwordCredentialValidatorResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"validator_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the plugin instance.",
+			},
+		},
+	}
+}
+
 func (r *passwordCredentialValidatorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("validator_id"), req, resp)
+	// Retrieve import ID or identity and save to id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("validator_id"), path.Root("validator_id"), req, resp)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
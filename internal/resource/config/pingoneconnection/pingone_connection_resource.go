@@ -6,9 +6,11 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -25,6 +27,7 @@ var (
 	_ resource.Resource                = &pingoneConnectionResource{}
 	_ resource.ResourceWithConfigure   = &pingoneConnectionResource{}
 	_ resource.ResourceWithImportState = &pingoneConnectionResource{}
+	_ resource.ResourceWithIdentity    = &pingoneConnectionResource{}
 
 	customId = "connection_id"
 )
@@ -47,6 +50,8 @@ type pingOneConnectionResourceModel struct {
 	Description                      types.String `tfsdk:"description"`
 	Active                           types.Bool   `tfsdk:"active"`
 	Credential                       types.String `tfsdk:"credential"`
+	CredentialWo                     types.String `tfsdk:"credential_wo"`
+	CredentialWoVersion              types.Int64  `tfsdk:"credential_wo_version"`
 	EncryptedCredential              types.String `tfsdk:"encrypted_credential"`
 	CredentialId                     types.String `tfsdk:"credential_id"`
 	PingOneConnectionId              types.String `tfsdk:"ping_one_connection_id"`
@@ -84,19 +89,38 @@ func (r *pingoneConnectionResource) Schema(ctx context.Context, req resource.Sch
 				Default:     booldefault.StaticBool(true),
 			},
 			"credential": schema.StringAttribute{
-				Description: "The credential for the PingOne connection. Either this attribute or `encrypted_credential` must be specified.",
+				Description: "The credential for the PingOne connection. Exactly one of this attribute, `credential_wo`, or `encrypted_credential` must be specified.",
 				Optional:    true,
 				Sensitive:   true,
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
 				},
 			},
+			"credential_wo": schema.StringAttribute{
+				Description: "Write-only credential for the PingOne connection, which is never stored in Terraform state. The credential is sent to PingFederate on each create and update, so change `credential_wo_version` to send an updated credential when no other attributes have changed. Exactly one of this attribute, `credential`, or `encrypted_credential` must be specified.",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"credential_wo_version": schema.Int64Attribute{
+				Description: "A version number for `credential_wo`. Changing the version triggers an update that sends the current value of `credential_wo` to PingFederate.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("credential_wo")),
+				},
+			},
 			"encrypted_credential": schema.StringAttribute{
-				Description: "The encrypted credential for the PingOne connection. Either this attribute or `credential` must be specified.",
+				Description: "The encrypted credential for the PingOne connection. Exactly one of this attribute, `credential`, or `credential_wo` must be specified.",
 				Optional:    true,
 				Computed:    true,
 				Validators: []validator.String{
-					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("credential")),
+					stringvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("credential"),
+						path.MatchRelative().AtParent().AtName("credential_wo"),
+					),
 				},
 			},
 			"credential_id": schema.StringAttribute{
@@ -146,7 +170,11 @@ func addOptionalPingOneConnectionFields(ctx context.Context, addRequest *client.
 	}
 	addRequest.Description = plan.Description.ValueStringPointer()
 	addRequest.Active = plan.Active.ValueBoolPointer()
-	addRequest.Credential = plan.Credential.ValueStringPointer()
+	if internaltypes.IsDefined(plan.CredentialWo) {
+		addRequest.Credential = plan.CredentialWo.ValueStringPointer()
+	} else {
+		addRequest.Credential = plan.Credential.ValueStringPointer()
+	}
 	addRequest.EncryptedCredential = plan.EncryptedCredential.ValueStringPointer()
 	addRequest.CredentialId = plan.CredentialId.ValueStringPointer()
 	addRequest.PingOneConnectionId = plan.PingOneConnectionId.ValueStringPointer()
@@ -190,6 +218,12 @@ func readPingOneConnectionResponse(ctx context.Context, r *client.PingOneConnect
 	} else {
 		state.EncryptedCredential = types.StringPointerValue(r.EncryptedCredential)
 	}
+	state.CredentialWo = types.StringNull()
+	if plan != nil {
+		state.CredentialWoVersion = plan.CredentialWoVersion
+	} else {
+		state.CredentialWoVersion = types.Int64Null()
+	}
 	state.CredentialId = types.StringPointerValue(r.CredentialId)
 	state.PingOneConnectionId = types.StringPointerValue(r.PingOneConnectionId)
 	state.EnvironmentId = types.StringPointerValue(r.EnvironmentId)
@@ -313,7 +347,18 @@ func (r *pingoneConnectionResource) Delete(ctx context.Context, req resource.Del
 	}
 }
 
+func (r *pingoneConnectionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"connection_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The persistent, unique ID of the connection.",
+			},
+		},
+	}
+}
+
 func (r *pingoneConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("connection_id"), req, resp)
+	// Retrieve import ID or identity and save to id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("connection_id"), path.Root("connection_id"), req, resp)
 }
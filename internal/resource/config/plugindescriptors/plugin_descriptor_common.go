@@ -0,0 +1,300 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package plugindescriptors
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/plugindescriptor"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+var (
+	optionValueAttrTypes = map[string]attr.Type{
+		"name":  types.StringType,
+		"value": types.StringType,
+	}
+	fieldAttrTypes = map[string]attr.Type{
+		"type":                      types.StringType,
+		"name":                      types.StringType,
+		"label":                     types.StringType,
+		"description":               types.StringType,
+		"default_value":             types.StringType,
+		"default_for_legacy_config": types.StringType,
+		"required":                  types.BoolType,
+		"advanced":                  types.BoolType,
+		"sensitive":                 types.BoolType,
+		"option_values":             types.ListType{ElemType: types.ObjectType{AttrTypes: optionValueAttrTypes}},
+	}
+	tableAttrTypes = map[string]attr.Type{
+		"name":                types.StringType,
+		"label":               types.StringType,
+		"description":         types.StringType,
+		"require_default_row": types.BoolType,
+		"columns":             types.ListType{ElemType: types.ObjectType{AttrTypes: fieldAttrTypes}},
+	}
+)
+
+func fieldsSchema(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: description,
+		Computed:    true,
+		Optional:    false,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Description: "The type of the field. Options are `RADIO_GROUP`, `SELECT`, `FILTERABLE_SELECT`, `CHECK_BOX`, `TEXT_AREA`, `TEXT`, `UPLOAD_FILE`, `HASHED_TEXT`.",
+					Computed:    true,
+					Optional:    false,
+				},
+				"name": schema.StringAttribute{
+					Description: "The name of the field, which is used as the `name` of the field in the plugin instance configuration.",
+					Computed:    true,
+					Optional:    false,
+				},
+				"label": schema.StringAttribute{
+					Description: "The label of the field displayed in the administrative console.",
+					Computed:    true,
+					Optional:    false,
+				},
+				"description": schema.StringAttribute{
+					Description: "The description of the field.",
+					Computed:    true,
+					Optional:    false,
+				},
+				"default_value": schema.StringAttribute{
+					Description: "The default value of the field, which PingFederate uses when the field is not included in the configuration.",
+					Computed:    true,
+					Optional:    false,
+				},
+				"default_for_legacy_config": schema.StringAttribute{
+					Description: "The default value of the field for existing configuration that does not include the field. When set, PingFederate uses this value instead of `default_value` when the field is not included in the configuration.",
+					Computed:    true,
+					Optional:    false,
+				},
+				"required": schema.BoolAttribute{
+					Description: "Whether a value is required for the field.",
+					Computed:    true,
+					Optional:    false,
+				},
+				"advanced": schema.BoolAttribute{
+					Description: "Whether the field is an advanced field.",
+					Computed:    true,
+					Optional:    false,
+				},
+				"sensitive": schema.BoolAttribute{
+					Description: "Whether the field is hashed or encrypted by PingFederate, so it should be set in `sensitive_fields` rather than `fields` in the plugin instance configuration.",
+					Computed:    true,
+					Optional:    false,
+				},
+				"option_values": schema.ListNestedAttribute{
+					Description: "The options of a selection field.",
+					Computed:    true,
+					Optional:    false,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"name": schema.StringAttribute{
+								Description: "The name of the option displayed in the administrative console.",
+								Computed:    true,
+								Optional:    false,
+							},
+							"value": schema.StringAttribute{
+								Description: "The value of the option, which is used as the value of the field in the plugin instance configuration.",
+								Computed:    true,
+								Optional:    false,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Get the schema attributes of a descriptor, other than its id
+func descriptorSchemaAttributes(family pluginFamily) map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Description: "The friendly name of the plugin type.",
+			Computed:    true,
+			Optional:    false,
+		},
+		"class_name": schema.StringAttribute{
+			Description: "The full class name of the class that implements the plugin type.",
+			Computed:    true,
+			Optional:    false,
+		},
+		"description": schema.StringAttribute{
+			Description: "The description of the plugin type.",
+			Computed:    true,
+			Optional:    false,
+		},
+		"attribute_contract": schema.ListAttribute{
+			Description: "The attribute contract of the plugin type.",
+			ElementType: types.StringType,
+			Computed:    true,
+			Optional:    false,
+		},
+		"supports_extended_contract": schema.BoolAttribute{
+			Description: "Whether plugin instances of the plugin type can extend the attribute contract.",
+			Computed:    true,
+			Optional:    false,
+		},
+		"fields": fieldsSchema("The configuration fields of the plugin type."),
+		"tables": schema.ListNestedAttribute{
+			Description: "The configuration tables of the plugin type.",
+			Computed:    true,
+			Optional:    false,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "The name of the table, which is used as the `name` of the table in the plugin instance configuration.",
+						Computed:    true,
+						Optional:    false,
+					},
+					"label": schema.StringAttribute{
+						Description: "The label of the table displayed in the administrative console.",
+						Computed:    true,
+						Optional:    false,
+					},
+					"description": schema.StringAttribute{
+						Description: "The description of the table.",
+						Computed:    true,
+						Optional:    false,
+					},
+					"require_default_row": schema.BoolAttribute{
+						Description: "Whether the table requires a default row.",
+						Computed:    true,
+						Optional:    false,
+					},
+					"columns": fieldsSchema("The columns of the table. Each row of the table has a field for each column."),
+				},
+			},
+		},
+	}
+	if family.tokenEndpointContract {
+		attributes["token_endpoint_attribute_contract"] = schema.ListAttribute{
+			Description: "The attributes of the plugin type that are returned by the token endpoint.",
+			ElementType: types.StringType,
+			Computed:    true,
+			Optional:    false,
+		}
+	}
+	if family.groupContract {
+		attributes["group_attribute_contract"] = schema.ListAttribute{
+			Description: "The group attribute contract of the plugin type.",
+			ElementType: types.StringType,
+			Computed:    true,
+			Optional:    false,
+		}
+		attributes["supports_group_extended_contract"] = schema.BoolAttribute{
+			Description: "Whether plugin instances of the plugin type can extend the group attribute contract.",
+			Computed:    true,
+			Optional:    false,
+		}
+	}
+	return attributes
+}
+
+func descriptorAttrTypes(family pluginFamily) map[string]attr.Type {
+	attrTypes := map[string]attr.Type{
+		"id":                         types.StringType,
+		"name":                       types.StringType,
+		"class_name":                 types.StringType,
+		"description":                types.StringType,
+		"attribute_contract":         types.ListType{ElemType: types.StringType},
+		"supports_extended_contract": types.BoolType,
+		"fields":                     types.ListType{ElemType: types.ObjectType{AttrTypes: fieldAttrTypes}},
+		"tables":                     types.ListType{ElemType: types.ObjectType{AttrTypes: tableAttrTypes}},
+	}
+	if family.tokenEndpointContract {
+		attrTypes["token_endpoint_attribute_contract"] = types.ListType{ElemType: types.StringType}
+	}
+	if family.groupContract {
+		attrTypes["group_attribute_contract"] = types.ListType{ElemType: types.StringType}
+		attrTypes["supports_group_extended_contract"] = types.BoolType
+	}
+	return attrTypes
+}
+
+// Build the values of the attributes of a descriptor
+func descriptorAttrValues(family pluginFamily, descriptor plugindescriptor.Descriptor) (map[string]attr.Value, diag.Diagnostics) {
+	var diags, respDiags diag.Diagnostics
+	values := map[string]attr.Value{
+		"id":                         types.StringValue(descriptor.Id),
+		"name":                       types.StringValue(descriptor.Name),
+		"class_name":                 types.StringValue(descriptor.ClassName),
+		"description":                types.StringNull(),
+		"attribute_contract":         internaltypes.GetStringList(descriptor.AttributeContract),
+		"supports_extended_contract": types.BoolValue(descriptor.SupportsExtendedContract),
+	}
+	if family.tokenEndpointContract {
+		values["token_endpoint_attribute_contract"] = internaltypes.GetStringList(descriptor.TokenEndpointAttributeContract)
+	}
+	if family.groupContract {
+		values["group_attribute_contract"] = internaltypes.GetStringList(descriptor.GroupAttributeContract)
+		values["supports_group_extended_contract"] = types.BoolValue(descriptor.SupportsGroupExtendedContract)
+	}
+
+	var fields []plugindescriptor.FieldDescriptor
+	var tables []attr.Value
+	if descriptor.ConfigDescriptor != nil {
+		values["description"] = types.StringPointerValue(descriptor.ConfigDescriptor.Description)
+		fields = descriptor.ConfigDescriptor.Fields
+		for _, table := range descriptor.ConfigDescriptor.Tables {
+			columns, respDiags := fieldsValue(table.Columns)
+			diags.Append(respDiags...)
+			tableValue, respDiags := types.ObjectValue(tableAttrTypes, map[string]attr.Value{
+				"name":                types.StringValue(table.Name),
+				"label":               types.StringPointerValue(table.Label),
+				"description":         types.StringPointerValue(table.Description),
+				"require_default_row": types.BoolValue(table.RequireDefaultRow),
+				"columns":             columns,
+			})
+			diags.Append(respDiags...)
+			tables = append(tables, tableValue)
+		}
+	}
+	values["fields"], respDiags = fieldsValue(fields)
+	diags.Append(respDiags...)
+	values["tables"], respDiags = types.ListValue(types.ObjectType{AttrTypes: tableAttrTypes}, tables)
+	diags.Append(respDiags...)
+	return values, diags
+}
+
+func fieldsValue(fields []plugindescriptor.FieldDescriptor) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var fieldValues []attr.Value
+	for _, field := range fields {
+		var optionValues []attr.Value
+		for _, option := range field.OptionValues {
+			optionValue, respDiags := types.ObjectValue(optionValueAttrTypes, map[string]attr.Value{
+				"name":  types.StringValue(option.Name),
+				"value": types.StringValue(option.Value),
+			})
+			diags.Append(respDiags...)
+			optionValues = append(optionValues, optionValue)
+		}
+		optionValuesList, respDiags := types.ListValue(types.ObjectType{AttrTypes: optionValueAttrTypes}, optionValues)
+		diags.Append(respDiags...)
+		fieldValue, respDiags := types.ObjectValue(fieldAttrTypes, map[string]attr.Value{
+			"type":                      types.StringValue(field.Type),
+			"name":                      types.StringValue(field.Name),
+			"label":                     types.StringPointerValue(field.Label),
+			"description":               types.StringPointerValue(field.Description),
+			"default_value":             types.StringPointerValue(field.DefaultValue),
+			"default_for_legacy_config": types.StringPointerValue(field.DefaultForLegacyConfig),
+			"required":                  types.BoolValue(field.Required),
+			"advanced":                  types.BoolValue(field.Advanced),
+			"sensitive":                 types.BoolValue(field.Sensitive()),
+			"option_values":             optionValuesList,
+		})
+		diags.Append(respDiags...)
+		fieldValues = append(fieldValues, fieldValue)
+	}
+	fieldsList, respDiags := types.ListValue(types.ObjectType{AttrTypes: fieldAttrTypes}, fieldValues)
+	diags.Append(respDiags...)
+	return fieldsList, diags
+}
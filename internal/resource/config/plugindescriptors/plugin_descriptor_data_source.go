@@ -0,0 +1,91 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package plugindescriptors
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/plugindescriptor"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &pluginDescriptorDataSource{}
+	_ datasource.DataSourceWithConfigure = &pluginDescriptorDataSource{}
+)
+
+// pluginDescriptorDataSource is the datasource implementation for a single descriptor of a plugin family.
+type pluginDescriptorDataSource struct {
+	family         pluginFamily
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// GetSchema defines the schema for the datasource.
+func (r *pluginDescriptorDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := descriptorSchemaAttributes(r.family)
+	attributes["id"] = schema.StringAttribute{
+		Description: "The ID of the plugin descriptor, which is usually the class name of the plugin type.",
+		Required:    true,
+	}
+	resp.Schema = schema.Schema{
+		Description: "Describes a " + r.family.description + " plugin type, including the fields and tables that can be set in the configuration of its plugin instances.",
+		Attributes:  attributes,
+	}
+}
+
+// Metadata returns the data source type name.
+func (r *pluginDescriptorDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.family.typeName + "_descriptor"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *pluginDescriptorDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+// Read the data source state and convert it into the model
+func (r *pluginDescriptorDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var id types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.family.get(config.AuthContext(ctx, r.providerConfig), r.apiClient, id.ValueString())
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the "+r.family.description+" descriptor", err, httpResp)
+		return
+	}
+	descriptor, err := plugindescriptor.FromResponse(httpResp)
+	if err != nil {
+		resp.Diagnostics.AddError(providererror.InternalProviderError, "An error occurred while reading the "+r.family.description+" descriptor: "+err.Error())
+		return
+	}
+
+	// Read the response into the state
+	values, respDiags := descriptorAttrValues(r.family, *descriptor)
+	resp.Diagnostics.Append(respDiags...)
+	state, respDiags := types.ObjectValue(descriptorAttrTypes(r.family), values)
+	resp.Diagnostics.Append(respDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
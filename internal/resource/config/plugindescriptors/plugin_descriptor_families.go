@@ -0,0 +1,200 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package plugindescriptors
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
+)
+
+// A family of plugin types, such as IdP adapters, that share an API for getting their descriptors
+type pluginFamily struct {
+	// Prefix of the data source type names, without the provider type name
+	typeName string
+	// Name of the plugin family used in descriptions, in singular form
+	description string
+	// Get the descriptor with the given ID. Only the HTTP response is used.
+	get func(ctx context.Context, apiClient *client.APIClient, id string) (*http.Response, error)
+	// Get all descriptors of the family. Only the HTTP response is used.
+	list func(ctx context.Context, apiClient *client.APIClient) (*http.Response, error)
+	// Whether descriptors of the family have a token endpoint attribute contract
+	tokenEndpointContract bool
+	// Whether descriptors of the family have a group attribute contract
+	groupContract bool
+}
+
+var pluginFamilies = []pluginFamily{
+	{
+		typeName:    "authentication_selector",
+		description: "authentication selector",
+		get: func(ctx context.Context, apiClient *client.APIClient, id string) (*http.Response, error) {
+			_, httpResp, err := apiClient.AuthenticationSelectorsAPI.GetAuthenticationSelectorDescriptorsById(ctx, id).Execute()
+			return httpResp, err
+		},
+		list: func(ctx context.Context, apiClient *client.APIClient) (*http.Response, error) {
+			_, httpResp, err := apiClient.AuthenticationSelectorsAPI.GetAuthenticationSelectorDescriptors(ctx).Execute()
+			return httpResp, err
+		},
+	},
+	{
+		typeName:    "captcha_provider",
+		description: "CAPTCHA provider",
+		get: func(ctx context.Context, apiClient *client.APIClient, id string) (*http.Response, error) {
+			_, httpResp, err := apiClient.CaptchaProvidersAPI.GetCaptchaProviderPluginDescriptor(ctx, id).Execute()
+			return httpResp, err
+		},
+		list: func(ctx context.Context, apiClient *client.APIClient) (*http.Response, error) {
+			_, httpResp, err := apiClient.CaptchaProvidersAPI.GetCaptchaProviderPluginDescriptors(ctx).Execute()
+			return httpResp, err
+		},
+	},
+	{
+		typeName:    "custom_data_store",
+		description: "custom data store",
+		get: func(ctx context.Context, apiClient *client.APIClient, id string) (*http.Response, error) {
+			_, httpResp, err := apiClient.DataStoresAPI.GetCustomDataStoreDescriptor(ctx, id).Execute()
+			return httpResp, err
+		},
+		list: func(ctx context.Context, apiClient *client.APIClient) (*http.Response, error) {
+			_, httpResp, err := apiClient.DataStoresAPI.GetCustomDataStoreDescriptors(ctx).Execute()
+			return httpResp, err
+		},
+	},
+	{
+		typeName:    "identity_store_provisioner",
+		description: "identity store provisioner",
+		get: func(ctx context.Context, apiClient *client.APIClient, id string) (*http.Response, error) {
+			_, httpResp, err := apiClient.IdentityStoreProvisionersAPI.GetIdentityStoreProvisionerDescriptorById(ctx, id).Execute()
+			return httpResp, err
+		},
+		list: func(ctx context.Context, apiClient *client.APIClient) (*http.Response, error) {
+			_, httpResp, err := apiClient.IdentityStoreProvisionersAPI.GetIdentityStoreProvisionerDescriptors(ctx).Execute()
+			return httpResp, err
+		},
+		groupContract: true,
+	},
+	{
+		typeName:    "idp_adapter",
+		description: "IdP adapter",
+		get: func(ctx context.Context, apiClient *client.APIClient, id string) (*http.Response, error) {
+			_, httpResp, err := apiClient.IdpAdaptersAPI.GetIdpAdapterDescriptorsById(ctx, id).Execute()
+			return httpResp, err
+		},
+		list: func(ctx context.Context, apiClient *client.APIClient) (*http.Response, error) {
+			_, httpResp, err := apiClient.IdpAdaptersAPI.GetIdpAdapterDescriptors(ctx).Execute()
+			return httpResp, err
+		},
+	},
+	{
+		typeName:    "idp_token_processor",
+		description: "IdP token processor",
+		get: func(ctx context.Context, apiClient *client.APIClient, id string) (*http.Response, error) {
+			_, httpResp, err := apiClient.IdpTokenProcessorsAPI.GetTokenProcessorDescriptorsById(ctx, id).Execute()
+			return httpResp, err
+		},
+		list: func(ctx context.Context, apiClient *client.APIClient) (*http.Response, error) {
+			_, httpResp, err := apiClient.IdpTokenProcessorsAPI.GetTokenProcessorDescriptors(ctx).Execute()
+			return httpResp, err
+		},
+	},
+	{
+		typeName:    "notification_publisher",
+		description: "notification publisher",
+		get: func(ctx context.Context, apiClient *client.APIClient, id string) (*http.Response, error) {
+			_, httpResp, err := apiClient.NotificationPublishersAPI.GetNotificationPublisherPluginDescriptor(ctx, id).Execute()
+			return httpResp, err
+		},
+		list: func(ctx context.Context, apiClient *client.APIClient) (*http.Response, error) {
+			_, httpResp, err := apiClient.NotificationPublishersAPI.GetNotificationPublisherPluginDescriptors(ctx).Execute()
+			return httpResp, err
+		},
+	},
+	{
+		typeName:    "oauth_access_token_manager",
+		description: "OAuth access token manager",
+		get: func(ctx context.Context, apiClient *client.APIClient, id string) (*http.Response, error) {
+			_, httpResp, err := apiClient.OauthAccessTokenManagersAPI.GetTokenManagerDescriptor(ctx, id).Execute()
+			return httpResp, err
+		},
+		list: func(ctx context.Context, apiClient *client.APIClient) (*http.Response, error) {
+			_, httpResp, err := apiClient.OauthAccessTokenManagersAPI.GetTokenManagerDescriptors(ctx).Execute()
+			return httpResp, err
+		},
+		tokenEndpointContract: true,
+	},
+	{
+		typeName:    "oauth_out_of_band_auth_plugin",
+		description: "OAuth out-of-band authentication plugin",
+		get: func(ctx context.Context, apiClient *client.APIClient, id string) (*http.Response, error) {
+			_, httpResp, err := apiClient.OauthOutOfBandAuthPluginsAPI.GetOOBAuthPluginDescriptor(ctx, id).Execute()
+			return httpResp, err
+		},
+		list: func(ctx context.Context, apiClient *client.APIClient) (*http.Response, error) {
+			_, httpResp, err := apiClient.OauthOutOfBandAuthPluginsAPI.GetOOBAuthPluginDescriptors(ctx).Execute()
+			return httpResp, err
+		},
+	},
+	{
+		typeName:    "password_credential_validator",
+		description: "password credential validator",
+		get: func(ctx context.Context, apiClient *client.APIClient, id string) (*http.Response, error) {
+			_, httpResp, err := apiClient.PasswordCredentialValidatorsAPI.GetPasswordCredentialValidatorDescriptor(ctx, id).Execute()
+			return httpResp, err
+		},
+		list: func(ctx context.Context, apiClient *client.APIClient) (*http.Response, error) {
+			_, httpResp, err := apiClient.PasswordCredentialValidatorsAPI.GetPasswordCredentialValidatorDescriptors(ctx).Execute()
+			return httpResp, err
+		},
+	},
+	{
+		typeName:    "secret_manager",
+		description: "secret manager",
+		get: func(ctx context.Context, apiClient *client.APIClient, id string) (*http.Response, error) {
+			_, httpResp, err := apiClient.SecretManagersAPI.GetSecretManagerPluginDescriptor(ctx, id).Execute()
+			return httpResp, err
+		},
+		list: func(ctx context.Context, apiClient *client.APIClient) (*http.Response, error) {
+			_, httpResp, err := apiClient.SecretManagersAPI.GetSecretManagerPluginDescriptors(ctx).Execute()
+			return httpResp, err
+		},
+	},
+	{
+		typeName:    "sp_adapter",
+		description: "SP adapter",
+		get: func(ctx context.Context, apiClient *client.APIClient, id string) (*http.Response, error) {
+			_, httpResp, err := apiClient.SpAdaptersAPI.GetSpAdapterDescriptorsById(ctx, id).Execute()
+			return httpResp, err
+		},
+		list: func(ctx context.Context, apiClient *client.APIClient) (*http.Response, error) {
+			_, httpResp, err := apiClient.SpAdaptersAPI.GetSpAdapterDescriptors(ctx).Execute()
+			return httpResp, err
+		},
+	},
+	{
+		typeName:    "sp_token_generator",
+		description: "SP token generator",
+		get: func(ctx context.Context, apiClient *client.APIClient, id string) (*http.Response, error) {
+			_, httpResp, err := apiClient.SpTokenGeneratorsAPI.GetTokenGeneratorDescriptorsById(ctx, id).Execute()
+			return httpResp, err
+		},
+		list: func(ctx context.Context, apiClient *client.APIClient) (*http.Response, error) {
+			_, httpResp, err := apiClient.SpTokenGeneratorsAPI.GetTokenGeneratorDescriptors(ctx).Execute()
+			return httpResp, err
+		},
+	},
+}
+
+// DataSources returns a data source for a single descriptor and a data source for all descriptors of each plugin family
+func DataSources() []func() datasource.DataSource {
+	var dataSources []func() datasource.DataSource
+	for _, family := range pluginFamilies {
+		dataSources = append(dataSources,
+			func() datasource.DataSource { return &pluginDescriptorDataSource{family: family} },
+			func() datasource.DataSource { return &pluginDescriptorsDataSource{family: family} },
+		)
+	}
+	return dataSources
+}
@@ -0,0 +1,103 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package plugindescriptors
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/plugindescriptor"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &pluginDescriptorsDataSource{}
+	_ datasource.DataSourceWithConfigure = &pluginDescriptorsDataSource{}
+)
+
+// pluginDescriptorsDataSource is the datasource implementation for all descriptors of a plugin family.
+type pluginDescriptorsDataSource struct {
+	family         pluginFamily
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// GetSchema defines the schema for the datasource.
+func (r *pluginDescriptorsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := descriptorSchemaAttributes(r.family)
+	attributes["id"] = schema.StringAttribute{
+		Description: "The ID of the plugin descriptor, which is usually the class name of the plugin type.",
+		Computed:    true,
+		Optional:    false,
+	}
+	resp.Schema = schema.Schema{
+		Description: "Describes all " + r.family.description + " plugin types available on the PingFederate server, including the fields and tables that can be set in the configuration of their plugin instances.",
+		Attributes: map[string]schema.Attribute{
+			"items": schema.ListNestedAttribute{
+				Description: "The " + r.family.description + " plugin descriptors.",
+				Computed:    true,
+				Optional:    false,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: attributes,
+				},
+			},
+		},
+	}
+}
+
+// Metadata returns the data source type name.
+func (r *pluginDescriptorsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.family.typeName + "_descriptors"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *pluginDescriptorsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+// Read the data source state and convert it into the model
+func (r *pluginDescriptorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	httpResp, err := r.family.list(config.AuthContext(ctx, r.providerConfig), r.apiClient)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the "+r.family.description+" descriptors", err, httpResp)
+		return
+	}
+	descriptors, err := plugindescriptor.ListFromResponse(httpResp)
+	if err != nil {
+		resp.Diagnostics.AddError(providererror.InternalProviderError, "An error occurred while reading the "+r.family.description+" descriptors: "+err.Error())
+		return
+	}
+
+	// Read the response into the state
+	itemType := types.ObjectType{AttrTypes: descriptorAttrTypes(r.family)}
+	var items []attr.Value
+	for _, descriptor := range descriptors {
+		values, respDiags := descriptorAttrValues(r.family, descriptor)
+		resp.Diagnostics.Append(respDiags...)
+		item, respDiags := types.ObjectValue(itemType.AttrTypes, values)
+		resp.Diagnostics.Append(respDiags...)
+		items = append(items, item)
+	}
+	itemsList, respDiags := types.ListValue(itemType, items)
+	resp.Diagnostics.Append(respDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("items"), itemsList)...)
+}
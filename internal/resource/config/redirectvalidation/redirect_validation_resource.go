@@ -4,9 +4,11 @@ package redirectvalidation
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -17,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/resourcewrapper"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/utils"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/version"
@@ -24,9 +28,10 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &redirectValidationResource{}
-	_ resource.ResourceWithConfigure   = &redirectValidationResource{}
-	_ resource.ResourceWithImportState = &redirectValidationResource{}
+	_ resource.Resource                            = &redirectValidationResource{}
+	_ resource.ResourceWithConfigure               = &redirectValidationResource{}
+	_ resource.ResourceWithImportState             = &redirectValidationResource{}
+	_ resourcewrapper.ResourceWithRestoreOnDestroy = &redirectValidationResource{}
 )
 
 var (
@@ -422,6 +427,38 @@ func (r *redirectValidationResource) Update(ctx context.Context, req resource.Up
 	resp.Diagnostics.Append(diags...)
 }
 
+// ReadConfiguration returns the JSON of the redirect validation settings currently on the server, so they can be restored on destroy
+func (r *redirectValidationResource) ReadConfiguration(ctx context.Context) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	response, httpResp, err := r.apiClient.RedirectValidationAPI.GetRedirectValidationSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &diags, "An error occurred while reading the redirect validation settings to restore on destroy", err, httpResp)
+		return nil, diags
+	}
+	configuration, err := json.Marshal(response)
+	if err != nil {
+		diags.AddError(providererror.InternalProviderError, "Failed to marshal the redirect validation settings to restore on destroy: "+err.Error())
+	}
+	return configuration, diags
+}
+
+// RestoreConfiguration puts redirect validation settings returned by ReadConfiguration back on the server
+func (r *redirectValidationResource) RestoreConfiguration(ctx context.Context, configuration []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var original client.RedirectValidationSettings
+	if err := json.Unmarshal(configuration, &original); err != nil {
+		diags.AddError(providererror.InternalProviderError, "Failed to unmarshal the original redirect validation settings from private state: "+err.Error())
+		return diags
+	}
+	apiUpdateRequest := r.apiClient.RedirectValidationAPI.UpdateRedirectValidationSettings(config.AuthContext(ctx, r.providerConfig))
+	apiUpdateRequest = apiUpdateRequest.Body(original)
+	_, httpResp, err := r.apiClient.RedirectValidationAPI.UpdateRedirectValidationSettingsExecute(apiUpdateRequest)
+	if err != nil {
+		config.ReportHttpError(ctx, &diags, "An error occurred while restoring the original redirect validation settings", err, httpResp)
+	}
+	return diags
+}
+
 // This config object is edit-only, so Terraform can't delete it.
 func (r *redirectValidationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
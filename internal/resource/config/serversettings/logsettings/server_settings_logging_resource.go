@@ -18,15 +18,17 @@ import (
 	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/resourcewrapper"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/version"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &serverSettingsLoggingResource{}
-	_ resource.ResourceWithConfigure   = &serverSettingsLoggingResource{}
-	_ resource.ResourceWithImportState = &serverSettingsLoggingResource{}
+	_ resource.Resource                               = &serverSettingsLoggingResource{}
+	_ resource.ResourceWithConfigure                  = &serverSettingsLoggingResource{}
+	_ resource.ResourceWithImportState                = &serverSettingsLoggingResource{}
+	_ resourcewrapper.ResourceWithVersionRequirements = &serverSettingsLoggingResource{}
 
 	logCategoriesAttrTypes = map[string]attr.Type{
 		"id":          types.StringType,
@@ -158,26 +160,9 @@ func (r *serverSettingsLoggingResource) Schema(ctx context.Context, req resource
 	resp.Schema = schema
 }
 
-func (r *serverSettingsLoggingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-	// Compare to version 13.0.0 of PF
-	compare, err := version.Compare(r.providerConfig.ProductVersion, version.PingFederate1300)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to compare PingFederate versions", err.Error())
-		return
-	}
-	pfVersionAtLeast1300 := compare >= 0
-	var plan *serverSettingsLoggingResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	if plan == nil {
-		return
-	}
-
-	// If verbose_logging_lifetime is set by the user and the PF version is not new enough, throw an error
-	if !pfVersionAtLeast1300 {
-		if internaltypes.IsDefined(plan.VerboseLoggingLifetime) {
-			version.AddUnsupportedAttributeError("verbose_logging_lifetime",
-				r.providerConfig.ProductVersion, version.PingFederate1300, &resp.Diagnostics)
-		}
+func (r *serverSettingsLoggingResource) VersionRequirements() []version.AttributeRequirement {
+	return []version.AttributeRequirement{
+		{Path: "verbose_logging_lifetime", Minimum: version.PingFederate1300},
 	}
 }
 
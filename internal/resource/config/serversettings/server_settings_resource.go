@@ -4,9 +4,11 @@ package serversettings
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -21,14 +23,16 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/configvalidators"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/resourcewrapper"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &serverSettingsResource{}
-	_ resource.ResourceWithConfigure   = &serverSettingsResource{}
-	_ resource.ResourceWithImportState = &serverSettingsResource{}
+	_ resource.Resource                            = &serverSettingsResource{}
+	_ resource.ResourceWithConfigure               = &serverSettingsResource{}
+	_ resource.ResourceWithImportState             = &serverSettingsResource{}
+	_ resourcewrapper.ResourceWithRestoreOnDestroy = &serverSettingsResource{}
 )
 
 // ServerSettingsResource is a helper function to simplify the provider implementation.
@@ -746,6 +750,38 @@ func (r *serverSettingsResource) Update(ctx context.Context, req resource.Update
 	resp.Diagnostics.Append(diags...)
 }
 
+// ReadConfiguration returns the JSON of the server settings currently on the server, so they can be restored on destroy
+func (r *serverSettingsResource) ReadConfiguration(ctx context.Context) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	response, httpResp, err := r.apiClient.ServerSettingsAPI.GetServerSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &diags, "An error occurred while reading the server settings to restore on destroy", err, httpResp)
+		return nil, diags
+	}
+	configuration, err := json.Marshal(response)
+	if err != nil {
+		diags.AddError(providererror.InternalProviderError, "Failed to marshal the server settings to restore on destroy: "+err.Error())
+	}
+	return configuration, diags
+}
+
+// RestoreConfiguration puts server settings returned by ReadConfiguration back on the server
+func (r *serverSettingsResource) RestoreConfiguration(ctx context.Context, configuration []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var original client.ServerSettings
+	if err := json.Unmarshal(configuration, &original); err != nil {
+		diags.AddError(providererror.InternalProviderError, "Failed to unmarshal the original server settings from private state: "+err.Error())
+		return diags
+	}
+	apiUpdateRequest := r.apiClient.ServerSettingsAPI.UpdateServerSettings(config.AuthContext(ctx, r.providerConfig))
+	apiUpdateRequest = apiUpdateRequest.Body(original)
+	_, httpResp, err := r.apiClient.ServerSettingsAPI.UpdateServerSettingsExecute(apiUpdateRequest)
+	if err != nil {
+		config.ReportHttpError(ctx, &diags, "An error occurred while restoring the original server settings", err, httpResp)
+	}
+	return diags
+}
+
 // This config object is edit-only, so Terraform can't delete it.
 func (r *serverSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
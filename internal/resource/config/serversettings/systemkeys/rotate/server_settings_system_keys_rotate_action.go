@@ -0,0 +1,64 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package serversettingssystemkeysrotate
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &serverSettingsSystemKeysRotateAction{}
+	_ action.ActionWithConfigure = &serverSettingsSystemKeysRotateAction{}
+)
+
+// ServerSettingsSystemKeysRotateAction is a helper function to simplify the provider implementation.
+func ServerSettingsSystemKeysRotateAction() action.Action {
+	return &serverSettingsSystemKeysRotateAction{}
+}
+
+// serverSettingsSystemKeysRotateAction is the action implementation.
+type serverSettingsSystemKeysRotateAction struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Metadata returns the action type name.
+func (a *serverSettingsSystemKeysRotateAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_settings_system_keys_rotate"
+}
+
+func (a *serverSettingsSystemKeysRotateAction) Configure(_ context.Context, req action.ConfigureRequest, _ *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	a.providerConfig = providerCfg.ProviderConfig
+	a.apiClient = providerCfg.ApiClient
+}
+
+// Schema defines the schema for the action.
+func (a *serverSettingsSystemKeysRotateAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Action to rotate the system encryption keys. The pending key becomes the current key, and the current key becomes the previous key.",
+	}
+}
+
+func (a *serverSettingsSystemKeysRotateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	_, httpResp, err := a.apiClient.ServerSettingsAPI.RotateSystemKeys(config.AuthContext(ctx, a.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while rotating the system keys", err, httpResp)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Rotated the system keys",
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -30,6 +31,7 @@ var (
 	_ resource.Resource                = &serverSettingsWsTrustStsSettingsIssuerCertificateResource{}
 	_ resource.ResourceWithConfigure   = &serverSettingsWsTrustStsSettingsIssuerCertificateResource{}
 	_ resource.ResourceWithImportState = &serverSettingsWsTrustStsSettingsIssuerCertificateResource{}
+	_ resource.ResourceWithIdentity    = &serverSettingsWsTrustStsSettingsIssuerCertificateResource{}
 
 	customId = "certificate_id"
 )
@@ -348,8 +350,19 @@ func (r *serverSettingsWsTrustStsSettingsIssuerCertificateResource) Delete(ctx c
 	}
 }
 
+func (r *serverSettingsWsTrustStsSettingsIssuerCertificateResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"certificate_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The persistent, unique ID for the certificate.",
+			},
+		},
+	}
+}
+
 func (r *serverSettingsWsTrustStsSettingsIssuerCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to certificate_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("certificate_id"), req, resp)
+	// Retrieve import ID or identity and save to certificate_id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("certificate_id"), path.Root("certificate_id"), req, resp)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -30,6 +31,7 @@ var (
 	_ resource.Resource                = &sessionAuthenticationPolicyResource{}
 	_ resource.ResourceWithConfigure   = &sessionAuthenticationPolicyResource{}
 	_ resource.ResourceWithImportState = &sessionAuthenticationPolicyResource{}
+	_ resource.ResourceWithIdentity    = &sessionAuthenticationPolicyResource{}
 )
 
 func SessionAuthenticationPolicyResource() resource.Resource {
@@ -342,7 +344,18 @@ func (r *sessionAuthenticationPolicyResource) Delete(ctx context.Context, req re
 	}
 }
 
+func (r *sessionAuthenticationPolicyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"policy_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The persistent, unique ID for the session policy.",
+			},
+		},
+	}
+}
+
 func (r *sessionAuthenticationPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to policy_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("policy_id"), req, resp)
+	// Retrieve import ID or identity and save to policy_id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("policy_id"), path.Root("policy_id"), req, resp)
 }
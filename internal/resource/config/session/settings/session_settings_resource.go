@@ -4,7 +4,9 @@ package sessionsettings
 
 import (
 	"context"
+	"encoding/json"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -12,14 +14,16 @@ import (
 	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/resourcewrapper"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &sessionSettingsResource{}
-	_ resource.ResourceWithConfigure   = &sessionSettingsResource{}
-	_ resource.ResourceWithImportState = &sessionSettingsResource{}
+	_ resource.Resource                            = &sessionSettingsResource{}
+	_ resource.ResourceWithConfigure               = &sessionSettingsResource{}
+	_ resource.ResourceWithImportState             = &sessionSettingsResource{}
+	_ resourcewrapper.ResourceWithRestoreOnDestroy = &sessionSettingsResource{}
 )
 
 // SessionSettingsResource is a helper function to simplify the provider implementation.
@@ -184,6 +188,38 @@ func (r *sessionSettingsResource) Update(ctx context.Context, req resource.Updat
 	resp.Diagnostics.Append(diags...)
 }
 
+// ReadConfiguration returns the JSON of the session settings currently on the server, so they can be restored on destroy
+func (r *sessionSettingsResource) ReadConfiguration(ctx context.Context) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	response, httpResp, err := r.apiClient.SessionAPI.GetSessionSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &diags, "An error occurred while reading the session settings to restore on destroy", err, httpResp)
+		return nil, diags
+	}
+	configuration, err := json.Marshal(response)
+	if err != nil {
+		diags.AddError(providererror.InternalProviderError, "Failed to marshal the session settings to restore on destroy: "+err.Error())
+	}
+	return configuration, diags
+}
+
+// RestoreConfiguration puts session settings returned by ReadConfiguration back on the server
+func (r *sessionSettingsResource) RestoreConfiguration(ctx context.Context, configuration []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var original client.SessionSettings
+	if err := json.Unmarshal(configuration, &original); err != nil {
+		diags.AddError(providererror.InternalProviderError, "Failed to unmarshal the original session settings from private state: "+err.Error())
+		return diags
+	}
+	apiUpdateRequest := r.apiClient.SessionAPI.UpdateSessionSettings(config.AuthContext(ctx, r.providerConfig))
+	apiUpdateRequest = apiUpdateRequest.Body(original)
+	_, httpResp, err := r.apiClient.SessionAPI.UpdateSessionSettingsExecute(apiUpdateRequest)
+	if err != nil {
+		config.ReportHttpError(ctx, &diags, "An error occurred while restoring the original session settings", err, httpResp)
+	}
+	return diags
+}
+
 // This config object is edit-only, so Terraform can't delete it.
 func (r *sessionSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 }
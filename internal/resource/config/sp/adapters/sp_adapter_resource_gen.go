@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -33,6 +34,7 @@ var (
 	_ resource.Resource                = &spAdapterResource{}
 	_ resource.ResourceWithConfigure   = &spAdapterResource{}
 	_ resource.ResourceWithImportState = &spAdapterResource{}
+	_ resource.ResourceWithIdentity    = &spAdapterResource{}
 
 	customId = "adapter_id"
 )
@@ -360,6 +362,7 @@ func (r *spAdapterResource) Create(ctx context.Context, req resource.CreateReque
 
 	// Read response into the model
 	resp.Diagnostics.Append(data.readClientResponse(responseData, false)...)
+	data.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, data.Configuration, responseData.PluginDescriptorRef.Id, false, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -392,6 +395,7 @@ func (r *spAdapterResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// Read response into the model
 	resp.Diagnostics.Append(data.readClientResponse(responseData, isImportRead)...)
+	data.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, data.Configuration, responseData.PluginDescriptorRef.Id, isImportRead, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -423,6 +427,7 @@ func (r *spAdapterResource) Update(ctx context.Context, req resource.UpdateReque
 
 	// Read response into the model
 	resp.Diagnostics.Append(data.readClientResponse(responseData, false)...)
+	data.Configuration = pluginconfiguration.ReadDefaultedFields(ctx, data.Configuration, responseData.PluginDescriptorRef.Id, false, r.getPluginDescriptor, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -445,8 +450,19 @@ func (r *spAdapterResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 }
 
+func (r *spAdapterResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"adapter_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the plugin instance.",
+			},
+		},
+	}
+}
+
 func (r *spAdapterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to adapter_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("adapter_id"), req, resp)
+	// Retrieve import ID or identity and save to adapter_id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("adapter_id"), path.Root("adapter_id"), req, resp)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
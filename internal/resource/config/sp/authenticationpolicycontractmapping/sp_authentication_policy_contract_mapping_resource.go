@@ -4,11 +4,13 @@ package spauthenticationpolicycontractmapping
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -29,6 +31,8 @@ var (
 	_ resource.Resource                = &spAuthenticationPolicyContractMappingResource{}
 	_ resource.ResourceWithConfigure   = &spAuthenticationPolicyContractMappingResource{}
 	_ resource.ResourceWithImportState = &spAuthenticationPolicyContractMappingResource{}
+	_ resource.ResourceWithIdentity    = &spAuthenticationPolicyContractMappingResource{}
+	_ resource.ResourceWithModifyPlan  = &spAuthenticationPolicyContractMappingResource{}
 )
 
 // SpAuthenticationPolicyContractMappingResource is a helper function to simplify the provider implementation.
@@ -145,6 +149,23 @@ func (r *spAuthenticationPolicyContractMappingResource) Configure(_ context.Cont
 	r.apiClient = providerCfg.ApiClient
 }
 
+func (r *spAuthenticationPolicyContractMappingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Validate the references to the source authentication policy contract
+	attributecontractfulfillment.ValidatePolicyContractSources(ctx, req.Config, path.Root("attribute_contract_fulfillment"), path.Root("source_id"),
+		r.getPolicyContract, &resp.Diagnostics)
+}
+
+// Get the attribute names of an authentication policy contract from PingFederate, for validating references to it
+func (r *spAuthenticationPolicyContractMappingResource) getPolicyContract(ctx context.Context, contractId string) (map[string]bool, *http.Response, error) {
+	return r.providerConfig.AuthenticationPolicyContracts.AttributeNames(ctx, contractId, func(ctx context.Context, contractId string) (*client.AuthenticationPolicyContract, *http.Response, error) {
+		return r.apiClient.AuthenticationPolicyContractsAPI.GetAuthenticationPolicyContract(config.AuthContext(ctx, r.providerConfig), contractId).Execute()
+	})
+}
+
 func readSpAuthenticationPolicyContractMappingResourceResponse(ctx context.Context, r *client.ApcToSpAdapterMapping, state *spAuthenticationPolicyContractMappingResourceModel) diag.Diagnostics {
 	var diags, respDiags diag.Diagnostics
 	state.AttributeSources, respDiags = attributesources.ToState(ctx, r.AttributeSources)
@@ -271,7 +292,18 @@ func (r *spAuthenticationPolicyContractMappingResource) Delete(ctx context.Conte
 	}
 
 }
+func (r *spAuthenticationPolicyContractMappingResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"mapping_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The id of the APC-to-SP Adapter mapping.",
+			},
+		},
+	}
+}
+
 func (r *spAuthenticationPolicyContractMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("mapping_id"), req, resp)
+	// Retrieve import ID or identity and save to id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("mapping_id"), path.Root("mapping_id"), req, resp)
 }
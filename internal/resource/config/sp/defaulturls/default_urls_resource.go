@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/movestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/configvalidators"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
@@ -24,6 +25,7 @@ var (
 	_ resource.Resource                = &defaultUrlsResource{}
 	_ resource.ResourceWithConfigure   = &defaultUrlsResource{}
 	_ resource.ResourceWithImportState = &defaultUrlsResource{}
+	_ resource.ResourceWithMoveState   = &defaultUrlsResource{}
 )
 
 func DefaultUrlsResource() resource.Resource {
@@ -39,6 +41,13 @@ func (r *defaultUrlsResource) Metadata(_ context.Context, req resource.MetadataR
 	resp.TypeName = req.ProviderTypeName + "_default_urls"
 }
 
+// Move the state of the removed pingfederate_idp_default_urls resource to this resource
+func (r *defaultUrlsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromRemovedResource("pingfederate_idp_default_urls", nil),
+	}
+}
+
 func (r *defaultUrlsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
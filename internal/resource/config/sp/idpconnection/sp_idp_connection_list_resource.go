@@ -0,0 +1,40 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package spidpconnection
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/resourcewrapper"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+// SpIdpConnectionListResource is a helper function to simplify the provider implementation.
+func SpIdpConnectionListResource() list.ListResource {
+	return resourcewrapper.WrapList(SpIdpConnectionResource, listSpIdpConnections, "Limits the IdP connections that are listed to those that match the filter. The filter is compared to the IdP connection name and partner entity ID fields. The comparison is a case-insensitive partial match.")
+}
+
+// Get a page of the IdP connections on the server
+func listSpIdpConnections(ctx context.Context, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration, listConfig resourcewrapper.ListConfig, page int64) ([]resourcewrapper.ListItem, bool, *http.Response, error) {
+	listRequest := apiClient.SpIdpConnectionsAPI.GetConnections(config.AuthContext(ctx, providerConfig)).Page(page).NumberPerPage(resourcewrapper.ListPageSize)
+	if internaltypes.IsDefined(listConfig.Filter) {
+		listRequest = listRequest.Filter(listConfig.Filter.ValueString())
+	}
+	responseData, httpResp, err := listRequest.Execute()
+	if err != nil {
+		return nil, false, httpResp, err
+	}
+
+	var items []resourcewrapper.ListItem
+	for _, idpConnection := range responseData.Items {
+		items = append(items, resourcewrapper.ListItem{
+			Id:          idpConnection.GetId(),
+			DisplayName: idpConnection.Name,
+		})
+	}
+	return items, len(responseData.Items) < resourcewrapper.ListPageSize, httpResp, nil
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
@@ -41,6 +43,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/configvalidators"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/pemcertificates"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/resourcewrapper"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/utils"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/version"
@@ -51,6 +54,9 @@ var (
 	_ resource.Resource                = &spIdpConnectionResource{}
 	_ resource.ResourceWithConfigure   = &spIdpConnectionResource{}
 	_ resource.ResourceWithImportState = &spIdpConnectionResource{}
+	_ resource.ResourceWithIdentity    = &spIdpConnectionResource{}
+
+	_ resourcewrapper.ResourceWithVersionRequirements = &spIdpConnectionResource{}
 
 	metadataReloadSettingsAttrTypes = map[string]attr.Type{
 		"metadata_url_ref":            types.ObjectType{AttrTypes: resourcelink.AttrType()},
@@ -64,9 +70,11 @@ var (
 	}
 
 	credentialsInboundBackChannelAuthHttpBasicCredentialsAttrTypes = map[string]attr.Type{
-		"password":           types.StringType,
-		"encrypted_password": types.StringType,
-		"username":           types.StringType,
+		"password":            types.StringType,
+		"password_wo":         types.StringType,
+		"password_wo_version": types.Int64Type,
+		"encrypted_password":  types.StringType,
+		"username":            types.StringType,
 	}
 	credentialsInboundBackChannelAuthAttrTypes = map[string]attr.Type{
 		"certs":                   types.ListType{ElemType: connectioncert.ObjType()},
@@ -77,9 +85,11 @@ var (
 		"verification_subject_dn": types.StringType,
 	}
 	credentialsOutboundBackChannelAuthHttpBasicCredentialsAttrTypes = map[string]attr.Type{
-		"password":           types.StringType,
-		"encrypted_password": types.StringType,
-		"username":           types.StringType,
+		"password":            types.StringType,
+		"password_wo":         types.StringType,
+		"password_wo_version": types.Int64Type,
+		"encrypted_password":  types.StringType,
+		"username":            types.StringType,
 	}
 
 	credentialsOutboundBackChannelAuthAttrTypes = map[string]attr.Type{
@@ -977,19 +987,40 @@ func (r *spIdpConnectionResource) Schema(ctx context.Context, req resource.Schem
 									"password": schema.StringAttribute{
 										Optional:            true,
 										Sensitive:           true,
-										Description:         "User password. Either this attribute or `encrypted_password` must be specified.",
-										MarkdownDescription: "User password. Either this attribute or `encrypted_password` must be specified.",
+										Description:         "User password. Exactly one of this attribute, `password_wo`, or `encrypted_password` must be specified.",
+										MarkdownDescription: "User password. Exactly one of this attribute, `password_wo`, or `encrypted_password` must be specified.",
+										Validators: []validator.String{
+											stringvalidator.LengthAtLeast(1),
+										},
+									},
+									"password_wo": schema.StringAttribute{
+										Optional:            true,
+										Sensitive:           true,
+										WriteOnly:           true,
+										Description:         "Write-only user password, which is never stored in Terraform state. The password is sent to PingFederate on each create and update, so change `password_wo_version` to send an updated password when no other attributes have changed. Exactly one of this attribute, `password`, or `encrypted_password` must be specified.",
+										MarkdownDescription: "Write-only user password, which is never stored in Terraform state. The password is sent to PingFederate on each create and update, so change `password_wo_version` to send an updated password when no other attributes have changed. Exactly one of this attribute, `password`, or `encrypted_password` must be specified.",
 										Validators: []validator.String{
 											stringvalidator.LengthAtLeast(1),
 										},
 									},
+									"password_wo_version": schema.Int64Attribute{
+										Optional:            true,
+										Description:         "A version number for `password_wo`. Changing the version triggers an update that sends the current value of `password_wo` to PingFederate.",
+										MarkdownDescription: "A version number for `password_wo`. Changing the version triggers an update that sends the current value of `password_wo` to PingFederate.",
+										Validators: []validator.Int64{
+											int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("password_wo")),
+										},
+									},
 									"encrypted_password": schema.StringAttribute{
-										Description:         "Encrypted user password. Either this attribute or `password` must be specified.",
-										MarkdownDescription: "Encrypted user password. Either this attribute or `password` must be specified.",
+										Description:         "Encrypted user password. Exactly one of this attribute, `password`, or `password_wo` must be specified.",
+										MarkdownDescription: "Encrypted user password. Exactly one of this attribute, `password`, or `password_wo` must be specified.",
 										Optional:            true,
 										Computed:            true,
 										Validators: []validator.String{
-											stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("password")),
+											stringvalidator.ExactlyOneOf(
+												path.MatchRelative().AtParent().AtName("password"),
+												path.MatchRelative().AtParent().AtName("password_wo"),
+											),
 										},
 									},
 								},
@@ -1037,19 +1068,40 @@ func (r *spIdpConnectionResource) Schema(ctx context.Context, req resource.Schem
 									"password": schema.StringAttribute{
 										Optional:            true,
 										Sensitive:           true,
-										Description:         "User password. Either this attribute or `encrypted_password` must be specified.",
-										MarkdownDescription: "User password. Either this attribute or `encrypted_password` must be specified.",
+										Description:         "User password. Exactly one of this attribute, `password_wo`, or `encrypted_password` must be specified.",
+										MarkdownDescription: "User password. Exactly one of this attribute, `password_wo`, or `encrypted_password` must be specified.",
 										Validators: []validator.String{
 											stringvalidator.LengthAtLeast(1),
 										},
 									},
+									"password_wo": schema.StringAttribute{
+										Optional:            true,
+										Sensitive:           true,
+										WriteOnly:           true,
+										Description:         "Write-only user password, which is never stored in Terraform state. The password is sent to PingFederate on each create and update, so change `password_wo_version` to send an updated password when no other attributes have changed. Exactly one of this attribute, `password`, or `encrypted_password` must be specified.",
+										MarkdownDescription: "Write-only user password, which is never stored in Terraform state. The password is sent to PingFederate on each create and update, so change `password_wo_version` to send an updated password when no other attributes have changed. Exactly one of this attribute, `password`, or `encrypted_password` must be specified.",
+										Validators: []validator.String{
+											stringvalidator.LengthAtLeast(1),
+										},
+									},
+									"password_wo_version": schema.Int64Attribute{
+										Optional:            true,
+										Description:         "A version number for `password_wo`. Changing the version triggers an update that sends the current value of `password_wo` to PingFederate.",
+										MarkdownDescription: "A version number for `password_wo`. Changing the version triggers an update that sends the current value of `password_wo` to PingFederate.",
+										Validators: []validator.Int64{
+											int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("password_wo")),
+										},
+									},
 									"encrypted_password": schema.StringAttribute{
-										Description:         "Encrypted user password. Either this attribute or `password` must be specified.",
-										MarkdownDescription: "Encrypted user password. Either this attribute or `password` must be specified.",
+										Description:         "Encrypted user password. Exactly one of this attribute, `password`, or `password_wo` must be specified.",
+										MarkdownDescription: "Encrypted user password. Exactly one of this attribute, `password`, or `password_wo` must be specified.",
 										Optional:            true,
 										Computed:            true,
 										Validators: []validator.String{
-											stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("password")),
+											stringvalidator.ExactlyOneOf(
+												path.MatchRelative().AtParent().AtName("password"),
+												path.MatchRelative().AtParent().AtName("password_wo"),
+											),
 										},
 									},
 								},
@@ -3712,6 +3764,19 @@ func (r *spIdpConnectionResource) Schema(ctx context.Context, req resource.Schem
 	resp.Schema = schema
 }
 
+func (r *spIdpConnectionResource) VersionRequirements() []version.AttributeRequirement {
+	return []version.AttributeRequirement{
+		{Path: "inbound_provisioning.custom_scim2_schema", Minimum: version.PingFederate1230},
+		{Path: "inbound_provisioning.scim_version", Minimum: version.PingFederate1230},
+		{Path: "inbound_provisioning.service_provider_config", Minimum: version.PingFederate1230},
+		{Path: "idp_browser_sso.oidc_provider_settings.audience", Minimum: version.PingFederate1230},
+		{Path: "idp_browser_sso.oidc_provider_settings.include_not_before_claim", Minimum: version.PingFederate1230},
+		{Path: "idp_browser_sso.oidc_provider_settings.lifetime", Minimum: version.PingFederate1230},
+		{Path: "idp_browser_sso.oidc_provider_settings.type", Minimum: version.PingFederate1230},
+		{Path: "idp_browser_sso.passthrough_errors", Minimum: version.PingFederate1300},
+	}
+}
+
 func (r *spIdpConnectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	// Compare to version 12.3.0 of PF
 	compare, err := version.Compare(r.providerConfig.ProductVersion, version.PingFederate1230)
@@ -3735,54 +3800,14 @@ func (r *spIdpConnectionResource) ModifyPlan(ctx context.Context, req resource.M
 		return
 	}
 
-	// If any of these fields are set by the user and the PF version is not new enough, throw an error
-	if !pfVersionAtLeast1230 {
-		if internaltypes.IsDefined(plan.InboundProvisioning) {
-			inboundProvisioningAttrs := plan.InboundProvisioning.Attributes()
-			if internaltypes.IsDefined(inboundProvisioningAttrs["custom_scim2_schema"]) {
-				version.AddUnsupportedAttributeError("idp_browser_sso.inbound_provisioning.custom_scim2_schema",
-					r.providerConfig.ProductVersion, version.PingFederate1230, &resp.Diagnostics)
-			}
-			if internaltypes.IsDefined(inboundProvisioningAttrs["scim_version"]) {
-				version.AddUnsupportedAttributeError("idp_browser_sso.inbound_provisioning.scim_version",
-					r.providerConfig.ProductVersion, version.PingFederate1230, &resp.Diagnostics)
-			}
-			if internaltypes.IsDefined(inboundProvisioningAttrs["service_provider_config"]) {
-				version.AddUnsupportedAttributeError("idp_browser_sso.inbound_provisioning.service_provider_config",
-					r.providerConfig.ProductVersion, version.PingFederate1230, &resp.Diagnostics)
-			}
-		}
-		if internaltypes.IsDefined(plan.IdpBrowserSso) {
-			browserSsoAttrs := plan.IdpBrowserSso.Attributes()
-			if internaltypes.IsDefined(browserSsoAttrs["oidc_provider_settings"]) {
-				oidcProviderSettingsAttrs := browserSsoAttrs["oidc_provider_settings"].(types.Object).Attributes()
-				if internaltypes.IsDefined(oidcProviderSettingsAttrs["audience"]) {
-					version.AddUnsupportedAttributeError("idp_browser_sso.oidc_provider_settings.audience",
-						r.providerConfig.ProductVersion, version.PingFederate1230, &resp.Diagnostics)
-				}
-				if internaltypes.IsDefined(oidcProviderSettingsAttrs["include_not_before_claim"]) {
-					version.AddUnsupportedAttributeError("idp_browser_sso.oidc_provider_settings.include_not_before_claim",
-						r.providerConfig.ProductVersion, version.PingFederate1230, &resp.Diagnostics)
-				}
-				if internaltypes.IsDefined(oidcProviderSettingsAttrs["lifetime"]) {
-					version.AddUnsupportedAttributeError("idp_browser_sso.oidc_provider_settings.lifetime",
-						r.providerConfig.ProductVersion, version.PingFederate1230, &resp.Diagnostics)
-				}
-				if internaltypes.IsDefined(oidcProviderSettingsAttrs["type"]) {
-					version.AddUnsupportedAttributeError("idp_browser_sso.oidc_provider_settings.type",
-						r.providerConfig.ProductVersion, version.PingFederate1230, &resp.Diagnostics)
-				}
-			}
-		}
-	}
-	if !pfVersionAtLeast1300 {
-		if internaltypes.IsDefined(plan.IdpBrowserSso) {
-			browserSsoAttrs := plan.IdpBrowserSso.Attributes()
-			if internaltypes.IsDefined(browserSsoAttrs["passthrough_errors"]) {
-				version.AddUnsupportedAttributeError("idp_browser_sso.passthrough_errors",
-					r.providerConfig.ProductVersion, version.PingFederate1300, &resp.Diagnostics)
-			}
-		}
+	// Validate that each authentication policy contract mapping only fulfills attributes of its contract
+	contractMappingsPath := path.Root("idp_browser_sso").AtName("authentication_policy_contract_mappings")
+	var contractMappings types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, contractMappingsPath, &contractMappings)...)
+	for i := range contractMappings.Elements() {
+		mappingPath := contractMappingsPath.AtListIndex(i)
+		attributecontractfulfillment.ValidatePolicyContractKeys(ctx, req.Config, mappingPath.AtName("attribute_contract_fulfillment"),
+			mappingPath.AtName("authentication_policy_contract_ref").AtName("id"), r.getPolicyContract, &resp.Diagnostics)
 	}
 
 	// Ensure that group attributes have appropriate values
@@ -4185,6 +4210,9 @@ func addOptionalSpIdpConnectionFields(ctx context.Context, addRequest *client.Id
 				credentialsInboundBackChannelAuthHttpBasicCredentialsAttrs := credentialsInboundBackChannelAuthAttrs["http_basic_credentials"].(types.Object).Attributes()
 				credentialsInboundBackChannelAuthHttpBasicCredentialsValue.EncryptedPassword = credentialsInboundBackChannelAuthHttpBasicCredentialsAttrs["encrypted_password"].(types.String).ValueStringPointer()
 				credentialsInboundBackChannelAuthHttpBasicCredentialsValue.Password = credentialsInboundBackChannelAuthHttpBasicCredentialsAttrs["password"].(types.String).ValueStringPointer()
+				if internaltypes.IsDefined(credentialsInboundBackChannelAuthHttpBasicCredentialsAttrs["password_wo"]) {
+					credentialsInboundBackChannelAuthHttpBasicCredentialsValue.Password = credentialsInboundBackChannelAuthHttpBasicCredentialsAttrs["password_wo"].(types.String).ValueStringPointer()
+				}
 				credentialsInboundBackChannelAuthHttpBasicCredentialsValue.Username = credentialsInboundBackChannelAuthHttpBasicCredentialsAttrs["username"].(types.String).ValueStringPointer()
 				credentialsInboundBackChannelAuthValue.HttpBasicCredentials = credentialsInboundBackChannelAuthHttpBasicCredentialsValue
 			}
@@ -4204,6 +4232,9 @@ func addOptionalSpIdpConnectionFields(ctx context.Context, addRequest *client.Id
 				credentialsOutboundBackChannelAuthHttpBasicCredentialsAttrs := credentialsOutboundBackChannelAuthAttrs["http_basic_credentials"].(types.Object).Attributes()
 				credentialsOutboundBackChannelAuthHttpBasicCredentialsValue.EncryptedPassword = credentialsOutboundBackChannelAuthHttpBasicCredentialsAttrs["encrypted_password"].(types.String).ValueStringPointer()
 				credentialsOutboundBackChannelAuthHttpBasicCredentialsValue.Password = credentialsOutboundBackChannelAuthHttpBasicCredentialsAttrs["password"].(types.String).ValueStringPointer()
+				if internaltypes.IsDefined(credentialsOutboundBackChannelAuthHttpBasicCredentialsAttrs["password_wo"]) {
+					credentialsOutboundBackChannelAuthHttpBasicCredentialsValue.Password = credentialsOutboundBackChannelAuthHttpBasicCredentialsAttrs["password_wo"].(types.String).ValueStringPointer()
+				}
 				credentialsOutboundBackChannelAuthHttpBasicCredentialsValue.Username = credentialsOutboundBackChannelAuthHttpBasicCredentialsAttrs["username"].(types.String).ValueStringPointer()
 				credentialsOutboundBackChannelAuthValue.HttpBasicCredentials = credentialsOutboundBackChannelAuthHttpBasicCredentialsValue
 			}
@@ -5010,6 +5041,13 @@ func (r *spIdpConnectionResource) Configure(_ context.Context, req resource.Conf
 
 }
 
+// Get the attribute names of an authentication policy contract from PingFederate, for validating references to it
+func (r *spIdpConnectionResource) getPolicyContract(ctx context.Context, contractId string) (map[string]bool, *http.Response, error) {
+	return r.providerConfig.AuthenticationPolicyContracts.AttributeNames(ctx, contractId, func(ctx context.Context, contractId string) (*client.AuthenticationPolicyContract, *http.Response, error) {
+		return r.apiClient.AuthenticationPolicyContractsAPI.GetAuthenticationPolicyContract(config.AuthContext(ctx, r.providerConfig), contractId).Execute()
+	})
+}
+
 func readSpIdpConnectionResponse(ctx context.Context, r *client.IdpConnection, plan, state *spIdpConnectionResourceModel, isImportRead bool) diag.Diagnostics {
 	var respDiags, diags diag.Diagnostics
 
@@ -5156,6 +5194,12 @@ func readSpIdpConnectionResponse(ctx context.Context, r *client.IdpConnection, p
 				} else if state != nil && internaltypes.IsDefined(state.Credentials) && state.Credentials.Attributes()["inbound_back_channel_auth"] != nil && state.Credentials.Attributes()["inbound_back_channel_auth"].(types.Object).Attributes()["http_basic_credentials"] != nil {
 					password = state.Credentials.Attributes()["inbound_back_channel_auth"].(types.Object).Attributes()["http_basic_credentials"].(types.Object).Attributes()["password"].(types.String).ValueStringPointer()
 				}
+				passwordWoVersion := types.Int64Null()
+				if plan != nil && plan.Credentials.Attributes()["inbound_back_channel_auth"] != nil && plan.Credentials.Attributes()["inbound_back_channel_auth"].(types.Object).Attributes()["http_basic_credentials"] != nil {
+					passwordWoVersion, _ = plan.Credentials.Attributes()["inbound_back_channel_auth"].(types.Object).Attributes()["http_basic_credentials"].(types.Object).Attributes()["password_wo_version"].(types.Int64)
+				} else if state != nil && internaltypes.IsDefined(state.Credentials) && state.Credentials.Attributes()["inbound_back_channel_auth"] != nil && state.Credentials.Attributes()["inbound_back_channel_auth"].(types.Object).Attributes()["http_basic_credentials"] != nil {
+					passwordWoVersion, _ = state.Credentials.Attributes()["inbound_back_channel_auth"].(types.Object).Attributes()["http_basic_credentials"].(types.Object).Attributes()["password_wo_version"].(types.Int64)
+				}
 				encryptedPassword := types.StringPointerValue(r.Credentials.InboundBackChannelAuth.HttpBasicCredentials.EncryptedPassword)
 				if plan != nil && plan.Credentials.Attributes()["inbound_back_channel_auth"] != nil && plan.Credentials.Attributes()["inbound_back_channel_auth"].(types.Object).Attributes()["http_basic_credentials"] != nil {
 					encryptedPasswordFromPlan := plan.Credentials.Attributes()["inbound_back_channel_auth"].(types.Object).Attributes()["http_basic_credentials"].(types.Object).Attributes()["encrypted_password"].(types.String)
@@ -5164,9 +5208,11 @@ func readSpIdpConnectionResponse(ctx context.Context, r *client.IdpConnection, p
 					}
 				}
 				credentialsInboundBackChannelAuthHttpBasicCredentialsValue, objDiags = types.ObjectValue(credentialsInboundBackChannelAuthHttpBasicCredentialsAttrTypes, map[string]attr.Value{
-					"password":           types.StringPointerValue(password),
-					"encrypted_password": encryptedPassword,
-					"username":           types.StringPointerValue(r.Credentials.InboundBackChannelAuth.HttpBasicCredentials.Username),
+					"password":            types.StringPointerValue(password),
+					"password_wo":         types.StringNull(),
+					"password_wo_version": passwordWoVersion,
+					"encrypted_password":  encryptedPassword,
+					"username":            types.StringPointerValue(r.Credentials.InboundBackChannelAuth.HttpBasicCredentials.Username),
 				})
 				respDiags.Append(objDiags...)
 			}
@@ -5199,6 +5245,12 @@ func readSpIdpConnectionResponse(ctx context.Context, r *client.IdpConnection, p
 				} else if state != nil && internaltypes.IsDefined(state.Credentials) && state.Credentials.Attributes()["outbound_back_channel_auth"] != nil && state.Credentials.Attributes()["outbound_back_channel_auth"].(types.Object).Attributes()["http_basic_credentials"] != nil {
 					password = state.Credentials.Attributes()["outbound_back_channel_auth"].(types.Object).Attributes()["http_basic_credentials"].(types.Object).Attributes()["password"].(types.String).ValueStringPointer()
 				}
+				passwordWoVersion := types.Int64Null()
+				if plan != nil && plan.Credentials.Attributes()["outbound_back_channel_auth"] != nil && plan.Credentials.Attributes()["outbound_back_channel_auth"].(types.Object).Attributes()["http_basic_credentials"] != nil {
+					passwordWoVersion, _ = plan.Credentials.Attributes()["outbound_back_channel_auth"].(types.Object).Attributes()["http_basic_credentials"].(types.Object).Attributes()["password_wo_version"].(types.Int64)
+				} else if state != nil && internaltypes.IsDefined(state.Credentials) && state.Credentials.Attributes()["outbound_back_channel_auth"] != nil && state.Credentials.Attributes()["outbound_back_channel_auth"].(types.Object).Attributes()["http_basic_credentials"] != nil {
+					passwordWoVersion, _ = state.Credentials.Attributes()["outbound_back_channel_auth"].(types.Object).Attributes()["http_basic_credentials"].(types.Object).Attributes()["password_wo_version"].(types.Int64)
+				}
 				encryptedPassword := types.StringPointerValue(r.Credentials.OutboundBackChannelAuth.HttpBasicCredentials.EncryptedPassword)
 				if plan != nil && plan.Credentials.Attributes()["outbound_back_channel_auth"] != nil && plan.Credentials.Attributes()["outbound_back_channel_auth"].(types.Object).Attributes()["http_basic_credentials"] != nil {
 					encryptedPasswordFromPlan := plan.Credentials.Attributes()["outbound_back_channel_auth"].(types.Object).Attributes()["http_basic_credentials"].(types.Object).Attributes()["encrypted_password"].(types.String)
@@ -5207,9 +5259,11 @@ func readSpIdpConnectionResponse(ctx context.Context, r *client.IdpConnection, p
 					}
 				}
 				credentialsOutboundBackChannelAuthHttpBasicCredentialsValue, objDiags = types.ObjectValue(credentialsOutboundBackChannelAuthHttpBasicCredentialsAttrTypes, map[string]attr.Value{
-					"password":           types.StringPointerValue(password),
-					"encrypted_password": encryptedPassword,
-					"username":           types.StringPointerValue(r.Credentials.OutboundBackChannelAuth.HttpBasicCredentials.Username),
+					"password":            types.StringPointerValue(password),
+					"password_wo":         types.StringNull(),
+					"password_wo_version": passwordWoVersion,
+					"encrypted_password":  encryptedPassword,
+					"username":            types.StringPointerValue(r.Credentials.OutboundBackChannelAuth.HttpBasicCredentials.Username),
 				})
 				respDiags.Append(objDiags...)
 			}
@@ -6367,8 +6421,19 @@ func (r *spIdpConnectionResource) Delete(ctx context.Context, req resource.Delet
 	}
 }
 
+func (r *spIdpConnectionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"connection_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The persistent, unique ID for the connection.",
+			},
+		},
+	}
+}
+
 func (r *spIdpConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to connection_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("connection_id"), req, resp)
+	// Retrieve import ID or identity and save to connection_id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("connection_id"), path.Root("connection_id"), req, resp)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -27,6 +28,7 @@ var (
 	_ resource.Resource                = &tokenProcessorToTokenGeneratorMappingResource{}
 	_ resource.ResourceWithConfigure   = &tokenProcessorToTokenGeneratorMappingResource{}
 	_ resource.ResourceWithImportState = &tokenProcessorToTokenGeneratorMappingResource{}
+	_ resource.ResourceWithIdentity    = &tokenProcessorToTokenGeneratorMappingResource{}
 )
 
 // TokenProcessorToTokenGeneratorMappingResource is a helper function to simplify the provider implementation.
@@ -247,7 +249,18 @@ func (r *tokenProcessorToTokenGeneratorMappingResource) Delete(ctx context.Conte
 	}
 }
 
+func (r *tokenProcessorToTokenGeneratorMappingResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"mapping_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The id of the Token Processor to Token Generator Mapping.",
+			},
+		},
+	}
+}
+
 func (r *tokenProcessorToTokenGeneratorMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("mapping_id"), req, resp)
+	// Retrieve import ID or identity and save to id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("mapping_id"), path.Root("mapping_id"), req, resp)
 }
@@ -8,23 +8,17 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"regexp"
 	"strings"
-	"unicode/utf8"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/api"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/fieldpath"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 )
 
-var (
-	// lowerOrDigitToUpper will match a sequence of a lowercase letter or digit followed by an uppercase letter
-	lowerOrDigitToUpper = regexp.MustCompile(`([a-z0-9])[A-Z]`)
-)
-
 // Get BasicAuth context with a username and password
 func BasicAuthContext(ctx context.Context, username, password string) context.Context {
 	return context.WithValue(ctx, client.ContextBasicAuth, client.BasicAuth{
@@ -59,15 +53,19 @@ func OAuthContext(ctx context.Context, transport *http.Transport, tokenUrl strin
 	})
 }
 
-// Get an OAuth context from a ProviderConfiguration
+// Get an OAuth context from a ProviderConfiguration. The provider's shared token source is used if available,
+// so that access tokens are cached across resources.
 func ProviderOAuthContext(ctx context.Context, providerConfig internaltypes.ProviderConfiguration) context.Context {
+	if providerConfig.OAuthTokenSource != nil {
+		return context.WithValue(ctx, client.ContextOAuth2, providerConfig.OAuthTokenSource)
+	}
 	return OAuthContext(ctx, providerConfig.Transport, *providerConfig.TokenUrl, *providerConfig.ClientId, *providerConfig.ClientSecret, providerConfig.Scopes)
 }
 
 func AuthContext(ctx context.Context, providerConfig internaltypes.ProviderConfiguration) context.Context {
 	if providerConfig.Username != nil && providerConfig.Password != nil {
 		return ProviderBasicAuthContext(ctx, providerConfig)
-	} else if providerConfig.ClientId != nil && providerConfig.ClientSecret != nil && providerConfig.TokenUrl != nil {
+	} else if providerConfig.OAuthTokenSource != nil || (providerConfig.ClientId != nil && providerConfig.ClientSecret != nil && providerConfig.TokenUrl != nil) {
 		return ProviderOAuthContext(ctx, providerConfig)
 	} else if providerConfig.AccessToken != nil {
 		return ProviderAccessTokenContext(ctx, providerConfig)
@@ -102,22 +100,6 @@ func AddResourceNotFoundWarning(ctx context.Context, diagnostics *diag.Diagnosti
 	}
 }
 
-func toTerraformIdentifier(pfIdentifier string) string {
-	// Insert an underscore between lowercase letter followed by uppercase letter
-	insertedUnderscores := lowerOrDigitToUpper.ReplaceAllStringFunc(pfIdentifier, func(s string) string {
-		firstRune, size := utf8.DecodeRuneInString(s)
-		if firstRune == utf8.RuneError && size <= 1 {
-			// The string is empty, return it
-			return s
-		}
-
-		return fmt.Sprintf("%s_%s", string(firstRune), strings.ToLower(s[size:]))
-	})
-
-	// Lowercase the final string
-	return strings.ToLower(insertedUnderscores)
-}
-
 // Report an HTTP error
 func ReportHttpError(ctx context.Context, diagnostics *diag.Diagnostics, errorSummary string, err error, httpResp *http.Response) {
 	ReportHttpErrorCustomId(ctx, diagnostics, errorSummary, err, httpResp, nil)
@@ -135,6 +117,11 @@ func ReportHttpErrorCustomId(ctx context.Context, diagnostics *diag.Diagnostics,
 			var pfError pingFederateErrorResponse
 			internalError = json.Unmarshal(body, &pfError)
 			if internalError == nil {
+				errorIds := []string{pfError.ResultId}
+				for _, validationError := range pfError.ValidationErrors {
+					errorIds = append(errorIds, validationError.ErrorId)
+				}
+				api.RecordCreateConflict(ctx, httpResp.StatusCode, errorIds...)
 				if len(pfError.ValidationErrors) == 0 {
 					var errorDetail strings.Builder
 					errorDetail.WriteString("Error summary: ")
@@ -168,22 +155,8 @@ func ReportHttpErrorCustomId(ctx context.Context, diagnostics *diag.Diagnostics,
 						errorDetail.WriteString(validationError.DeveloperMessage)
 					}
 					if validationError.FieldPath != "" {
-						tfFieldPath := validationError.FieldPath
-						if customId != nil && tfFieldPath == "id" {
-							tfFieldPath = *customId
-						}
-						tfAttrName := toTerraformIdentifier(tfFieldPath)
-						// Attempt to build the terraform field path from the attribute name
-						fieldSteps := strings.Split(tfAttrName, ".")
-						var fieldPath path.Path
-						for i, step := range fieldSteps {
-							if i == 0 {
-								fieldPath = path.Root(step)
-							} else {
-								fieldPath = fieldPath.AtMapKey(step)
-							}
-						}
-						diagnostics.AddAttributeError(fieldPath, providererror.PingFederateValidationError, errorDetail.String())
+						// The resource wrapper maps the field path onto the schema of the resource
+						diagnostics.Append(fieldpath.NewDiagnostic(validationError.FieldPath, customId, providererror.PingFederateValidationError, errorDetail.String()))
 					} else {
 						diagnostics.AddError(providererror.PingFederateValidationError, errorDetail.String())
 					}
@@ -13,6 +13,9 @@ import (
 
 var _ validator.String = &pingFederateIdValidator{}
 
+// Runs of characters that are not valid in a PingFederate ID
+var InvalidPingFederateIdCharacters = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
 type pingFederateIdValidator struct{}
 
 func (v pingFederateIdValidator) Description(ctx context.Context) string {
@@ -30,8 +33,7 @@ func (v pingFederateIdValidator) ValidateString(ctx context.Context, req validat
 	}
 
 	strVal := req.ConfigValue.ValueString()
-	isMatch, _ := regexp.MatchString(`[^a-zA-Z0-9._-]`, strVal)
-	if isMatch {
+	if InvalidPingFederateIdCharacters.MatchString(strVal) {
 		resp.Diagnostics.AddAttributeError(
 			req.Path,
 			providererror.InvalidAttributeConfiguration,
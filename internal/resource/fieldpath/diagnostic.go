@@ -0,0 +1,58 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package fieldpath
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+var _ diag.DiagnosticWithPath = Diagnostic{}
+
+// Diagnostic is an attribute error for a PingFederate validation error. It keeps the field path returned by
+// PingFederate, so that the path can be mapped onto the schema of the resource by MapDiagnostics. Until then, the
+// attribute path is the FallbackPath of the field path.
+type Diagnostic struct {
+	diag.DiagnosticWithPath
+	FieldPath string
+	CustomId  *string
+}
+
+// NewDiagnostic creates an attribute error for the PingFederate field path
+func NewDiagnostic(fieldPath string, customId *string, summary, detail string) Diagnostic {
+	return Diagnostic{
+		DiagnosticWithPath: diag.NewAttributeErrorDiagnostic(FallbackPath(fieldPath, customId), summary, detail),
+		FieldPath:          fieldPath,
+		CustomId:           customId,
+	}
+}
+
+// Equal returns true if the other diagnostic is for the same PingFederate field path and is otherwise equal
+func (d Diagnostic) Equal(other diag.Diagnostic) bool {
+	o, ok := other.(Diagnostic)
+	if !ok {
+		return false
+	}
+	return d.FieldPath == o.FieldPath && d.DiagnosticWithPath.Equal(o.DiagnosticWithPath)
+}
+
+// MapDiagnostics replaces the path of each Diagnostic with the path of its PingFederate field path in the resource
+// schema. When no attribute in the schema matches the field path, the diagnostic is reported without a path. Other
+// diagnostics are returned unchanged.
+func MapDiagnostics(s schema.Schema, diags diag.Diagnostics) diag.Diagnostics {
+	var result diag.Diagnostics
+	for _, d := range diags {
+		fieldPathDiag, ok := d.(Diagnostic)
+		if !ok {
+			result = append(result, d)
+			continue
+		}
+		attrPath, found := ToPath(s, fieldPathDiag.FieldPath, fieldPathDiag.CustomId)
+		if found {
+			result = append(result, diag.NewAttributeErrorDiagnostic(attrPath, d.Summary(), d.Detail()))
+		} else {
+			result = append(result, diag.NewErrorDiagnostic(d.Summary(), d.Detail()))
+		}
+	}
+	return result
+}
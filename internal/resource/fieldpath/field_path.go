@@ -0,0 +1,184 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package fieldpath
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+var (
+	// lowerOrDigitToUpper will match a sequence of a lowercase letter or digit followed by an uppercase letter
+	lowerOrDigitToUpper = regexp.MustCompile(`([a-z0-9])[A-Z]`)
+	// fieldPathStep matches a field name or a bracketed list index or map key in a PingFederate field path
+	fieldPathStep = regexp.MustCompile(`[^.\[\]]+|\[[^\]]*\]`)
+)
+
+// A step in a PingFederate field path. Steps are either a field name, or a list index or map key within the
+// previous field.
+type step struct {
+	name    string
+	index   int
+	key     string
+	isIndex bool
+	isKey   bool
+}
+
+// ToTerraformIdentifier converts a PingFederate camel case identifier to a snake case Terraform identifier
+func ToTerraformIdentifier(pfIdentifier string) string {
+	// Insert an underscore between lowercase letter followed by uppercase letter
+	insertedUnderscores := lowerOrDigitToUpper.ReplaceAllStringFunc(pfIdentifier, func(s string) string {
+		firstRune, size := utf8.DecodeRuneInString(s)
+		if firstRune == utf8.RuneError && size <= 1 {
+			// The string is empty, return it
+			return s
+		}
+
+		return fmt.Sprintf("%s_%s", string(firstRune), strings.ToLower(s[size:]))
+	})
+
+	// Lowercase the final string
+	return strings.ToLower(insertedUnderscores)
+}
+
+// Parse a PingFederate field path, such as spBrowserSso.attributeContract.coreAttributes[0].name, into steps
+func parse(fieldPath string) []step {
+	var steps []step
+	for _, token := range fieldPathStep.FindAllString(fieldPath, -1) {
+		if !strings.HasPrefix(token, "[") {
+			steps = append(steps, step{name: token})
+			continue
+		}
+		bracketed := strings.TrimSuffix(strings.TrimPrefix(token, "["), "]")
+		if index, err := strconv.Atoi(bracketed); err == nil {
+			steps = append(steps, step{index: index, isIndex: true})
+		} else {
+			steps = append(steps, step{key: strings.Trim(bracketed, `'"`), isKey: true})
+		}
+	}
+	return steps
+}
+
+// Normalize an identifier for comparing names that differ only in case and underscores, such as x509File and
+// x509_file, or oidcClientID and oidc_client_id
+func normalize(identifier string) string {
+	return strings.ToLower(strings.ReplaceAll(identifier, "_", ""))
+}
+
+// Find the attribute for a PingFederate field name among the given attributes
+func findAttribute(attributes map[string]schema.Attribute, name string) (string, bool) {
+	tfName := ToTerraformIdentifier(name)
+	if _, ok := attributes[tfName]; ok {
+		return tfName, true
+	}
+	for attrName := range attributes {
+		if normalize(attrName) == normalize(name) {
+			return attrName, true
+		}
+	}
+	return "", false
+}
+
+// Get the attributes of the objects nested in an attribute, if any
+func nestedAttributes(attribute schema.Attribute) map[string]schema.Attribute {
+	switch a := attribute.(type) {
+	case schema.SingleNestedAttribute:
+		return a.Attributes
+	case schema.ListNestedAttribute:
+		return a.NestedObject.Attributes
+	case schema.SetNestedAttribute:
+		return a.NestedObject.Attributes
+	case schema.MapNestedAttribute:
+		return a.NestedObject.Attributes
+	}
+	return nil
+}
+
+// ToPath maps a PingFederate field path onto an attribute path in the given resource schema. Field names are matched
+// to the attributes of the schema, list indices are added to list attributes, and map keys to map attributes. Since
+// set elements can't be identified by an index, a path into a set ends at the set attribute. Any other steps that
+// can't be found in the schema end the path at the last attribute that was found. If customId is set, the id field
+// of PingFederate is mapped to that root attribute. The returned bool is false if no attribute could be found.
+func ToPath(s schema.Schema, fieldPath string, customId *string) (path.Path, bool) {
+	var result path.Path
+	found := false
+	attributes := s.Attributes
+	var current schema.Attribute
+	for _, step := range parse(fieldPath) {
+		if step.isIndex || step.isKey {
+			switch current.(type) {
+			case schema.ListNestedAttribute, schema.ListAttribute:
+				if !step.isIndex {
+					return result, found
+				}
+				result = result.AtListIndex(step.index)
+			case schema.MapNestedAttribute, schema.MapAttribute:
+				key := step.key
+				if step.isIndex {
+					key = strconv.Itoa(step.index)
+				}
+				result = result.AtMapKey(key)
+			default:
+				// Set elements and any other values can't be indexed
+				return result, found
+			}
+			attributes = nestedAttributes(current)
+			current = nil
+			continue
+		}
+
+		// A field within a list, set or map must follow an index or key
+		if _, isSingleNested := current.(schema.SingleNestedAttribute); current != nil && !isSingleNested {
+			return result, found
+		}
+		var attrName string
+		var ok bool
+		if !found && customId != nil && step.name == "id" {
+			attrName = *customId
+			_, ok = attributes[attrName]
+		} else {
+			attrName, ok = findAttribute(attributes, step.name)
+		}
+		if !ok {
+			return result, found
+		}
+		if !found {
+			result = path.Root(attrName)
+			found = true
+		} else {
+			result = result.AtName(attrName)
+		}
+		current = attributes[attrName]
+		attributes = nestedAttributes(current)
+	}
+	return result, found
+}
+
+// FallbackPath converts a PingFederate field path to an attribute path without a schema, by converting each field
+// name to a Terraform identifier and adding list indices and map keys as they appear in the field path.
+func FallbackPath(fieldPath string, customId *string) path.Path {
+	var result path.Path
+	for i, step := range parse(fieldPath) {
+		switch {
+		case step.isIndex:
+			result = result.AtListIndex(step.index)
+		case step.isKey:
+			result = result.AtMapKey(step.key)
+		case i == 0:
+			if customId != nil && step.name == "id" {
+				result = path.Root(*customId)
+			} else {
+				result = path.Root(ToTerraformIdentifier(step.name))
+			}
+		default:
+			result = result.AtName(ToTerraformIdentifier(step.name))
+		}
+	}
+	return result
+}
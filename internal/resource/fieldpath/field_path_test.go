@@ -0,0 +1,220 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package fieldpath
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var testSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id":            schema.StringAttribute{Computed: true},
+		"connection_id": schema.StringAttribute{Optional: true},
+		"sp_browser_sso": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"attribute_contract": schema.SingleNestedAttribute{
+					Optional: true,
+					Attributes: map[string]schema.Attribute{
+						"core_attributes": schema.ListNestedAttribute{
+							Optional: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{Required: true},
+								},
+							},
+						},
+						"extended_attributes": schema.SetNestedAttribute{
+							Optional: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{Required: true},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"x509file": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"file_data": schema.StringAttribute{Required: true},
+			},
+		},
+		"extended_properties": schema.MapNestedAttribute{
+			Optional: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"values": schema.SetAttribute{ElementType: types.StringType, Optional: true},
+				},
+			},
+		},
+	},
+}
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		fieldPath string
+		expected  []step
+	}{
+		{
+			fieldPath: "name",
+			expected:  []step{{name: "name"}},
+		},
+		{
+			fieldPath: "spBrowserSso.attributeContract.coreAttributes[0].name",
+			expected: []step{
+				{name: "spBrowserSso"},
+				{name: "attributeContract"},
+				{name: "coreAttributes"},
+				{index: 0, isIndex: true},
+				{name: "name"},
+			},
+		},
+		{
+			fieldPath: "extendedProperties['department'].values",
+			expected: []step{
+				{name: "extendedProperties"},
+				{key: "department", isKey: true},
+				{name: "values"},
+			},
+		},
+		{
+			fieldPath: "",
+			expected:  nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.fieldPath, func(t *testing.T) {
+			steps := parse(testCase.fieldPath)
+			if !reflect.DeepEqual(steps, testCase.expected) {
+				t.Errorf("expected %+v, got %+v", testCase.expected, steps)
+			}
+		})
+	}
+}
+
+func TestToPath(t *testing.T) {
+	customId := "connection_id"
+	testCases := []struct {
+		name          string
+		fieldPath     string
+		customId      *string
+		expectedPath  path.Path
+		expectedFound bool
+	}{
+		{
+			name:          "list index in nested attributes",
+			fieldPath:     "spBrowserSso.attributeContract.coreAttributes[0].name",
+			expectedPath:  path.Root("sp_browser_sso").AtName("attribute_contract").AtName("core_attributes").AtListIndex(0).AtName("name"),
+			expectedFound: true,
+		},
+		{
+			name:          "path into a set ends at the set",
+			fieldPath:     "spBrowserSso.attributeContract.extendedAttributes[1].name",
+			expectedPath:  path.Root("sp_browser_sso").AtName("attribute_contract").AtName("extended_attributes"),
+			expectedFound: true,
+		},
+		{
+			name:          "attribute renamed from the converted identifier",
+			fieldPath:     "x509File.fileData",
+			expectedPath:  path.Root("x509file").AtName("file_data"),
+			expectedFound: true,
+		},
+		{
+			name:          "map key",
+			fieldPath:     "extendedProperties['department'].values",
+			expectedPath:  path.Root("extended_properties").AtMapKey("department").AtName("values"),
+			expectedFound: true,
+		},
+		{
+			name:          "unknown field ends the path",
+			fieldPath:     "spBrowserSso.unknownField.name",
+			expectedPath:  path.Root("sp_browser_sso"),
+			expectedFound: true,
+		},
+		{
+			name:          "id without a custom id",
+			fieldPath:     "id",
+			expectedPath:  path.Root("id"),
+			expectedFound: true,
+		},
+		{
+			name:          "id renamed by the custom id",
+			fieldPath:     "id",
+			customId:      &customId,
+			expectedPath:  path.Root("connection_id"),
+			expectedFound: true,
+		},
+		{
+			name:      "unknown root field",
+			fieldPath: "unknownField",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, found := ToPath(testSchema, testCase.fieldPath, testCase.customId)
+			if found != testCase.expectedFound {
+				t.Fatalf("expected found %t, got %t", testCase.expectedFound, found)
+			}
+			if found && !result.Equal(testCase.expectedPath) {
+				t.Errorf("expected %s, got %s", testCase.expectedPath, result)
+			}
+		})
+	}
+}
+
+func TestFallbackPath(t *testing.T) {
+	customId := "connection_id"
+	testCases := []struct {
+		name         string
+		fieldPath    string
+		customId     *string
+		expectedPath path.Path
+	}{
+		{
+			name:         "list index in nested attributes",
+			fieldPath:    "spBrowserSso.attributeContract.coreAttributes[0].name",
+			expectedPath: path.Root("sp_browser_sso").AtName("attribute_contract").AtName("core_attributes").AtListIndex(0).AtName("name"),
+		},
+		{
+			name:         "identifier with digits",
+			fieldPath:    "x509File.fileData",
+			expectedPath: path.Root("x509_file").AtName("file_data"),
+		},
+		{
+			name:         "map key",
+			fieldPath:    "extendedProperties['department'].values",
+			expectedPath: path.Root("extended_properties").AtMapKey("department").AtName("values"),
+		},
+		{
+			name:         "id renamed by the custom id",
+			fieldPath:    "id",
+			customId:     &customId,
+			expectedPath: path.Root("connection_id"),
+		},
+		{
+			name:         "nested id not renamed by the custom id",
+			fieldPath:    "dataStoreRef.id",
+			customId:     &customId,
+			expectedPath: path.Root("data_store_ref").AtName("id"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := FallbackPath(testCase.fieldPath, testCase.customId)
+			if !result.Equal(testCase.expectedPath) {
+				t.Errorf("expected %s, got %s", testCase.expectedPath, result)
+			}
+		})
+	}
+}
@@ -7,23 +7,55 @@ import (
 	"strings"
 )
 
+const (
+	pemHeader = "-----BEGIN CERTIFICATE-----"
+	pemFooter = "-----END CERTIFICATE-----"
+)
+
+// Remove header, footer, and new lines
+var fileDataReplacer = strings.NewReplacer(pemHeader, "", pemFooter, "", "\n", "")
+
 // Compare PEM encoded certificates, handling any formatting done by the PF API
 func FileDataEquivalent(planned, apiResponse string) bool {
 	var plannedFormatted, apiResponseFormatted, plannedBase64Decoded string
 
-	// Remove header, footer, and new lines
-	stringReplacer := strings.NewReplacer("-----BEGIN CERTIFICATE-----", "", "-----END CERTIFICATE-----", "", "\n", "")
-
-	plannedFormatted = stringReplacer.Replace(planned)
+	plannedFormatted = fileDataReplacer.Replace(planned)
 	base64DecodedPlannedBytes, err := base64.StdEncoding.DecodeString(planned)
 	if err == nil {
 		// The plan value was base64-encoded, use the decoded value for comparison
 		plannedBase64Decoded = string(base64DecodedPlannedBytes)
 	}
-	plannedBase64Decoded = stringReplacer.Replace(plannedBase64Decoded)
+	plannedBase64Decoded = fileDataReplacer.Replace(plannedBase64Decoded)
 
-	apiResponseFormatted = stringReplacer.Replace(apiResponse)
+	apiResponseFormatted = fileDataReplacer.Replace(apiResponse)
 
 	// If the formatted versions match (base64 decoded or not), these represent the same certificate
 	return plannedFormatted == apiResponseFormatted || plannedBase64Decoded == apiResponseFormatted
 }
+
+// Get the base64-encoded DER data of a certificate from PEM encoded file data, which may itself be base64-encoded.
+// The result has no header, footer, or new lines, so it is the same for any formatting of the same certificate.
+func StrippedFileData(fileData string) string {
+	fileData = strings.ReplaceAll(fileData, "\r", "")
+	if decoded, err := base64.StdEncoding.DecodeString(fileData); err == nil && strings.Contains(string(decoded), pemHeader) {
+		// The file data was base64-encoded PEM
+		fileData = strings.ReplaceAll(string(decoded), "\r", "")
+	}
+	return strings.TrimSpace(fileDataReplacer.Replace(fileData))
+}
+
+// Format a certificate as PEM, with the base64-encoded data wrapped at 64 characters
+func NormalizedFileData(fileData string) string {
+	stripped := StrippedFileData(fileData)
+	var builder strings.Builder
+	builder.WriteString(pemHeader + "\n")
+	for len(stripped) > 64 {
+		builder.WriteString(stripped[:64] + "\n")
+		stripped = stripped[64:]
+	}
+	if stripped != "" {
+		builder.WriteString(stripped + "\n")
+	}
+	builder.WriteString(pemFooter + "\n")
+	return builder.String()
+}
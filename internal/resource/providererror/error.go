@@ -20,8 +20,12 @@ const (
 	PingFederateValidationError     = "PingFederate validation error"
 	PingFederateAPIError            = "PingFederate API error"
 	ConfigurationWarning            = "Plugin configuration warning"
+	ContractReferenceWarning        = "Attribute not found in contract"
 	ConfigurationCannotBeResetError = "Configuration cannot be returned to original state"
+	ConfigurationChangedBeforeReset = "Configuration changed outside of Terraform before being returned to original state"
 	ConflictingValueReturnedError   = "PingFederate returned conflicting value"
+	ReadOnlyModeError               = "Provider is in read-only mode"
+	ExistingObjectAdopted           = "Existing object adopted"
 )
 
 func WarnConfigurationCannotBeReset(resourceName string, diags *diag.Diagnostics) {
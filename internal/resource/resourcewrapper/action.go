@@ -0,0 +1,138 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package resourcewrapper
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+var (
+	_ action.Action              = &wrappedAction{}
+	_ action.ActionWithConfigure = &wrappedAction{}
+)
+
+// WrapAllActions applies WrapAction to each action.
+func WrapAllActions(factories []func() action.Action) []func() action.Action {
+	wrapped := make([]func() action.Action, 0, len(factories))
+	for _, factory := range factories {
+		wrapped = append(wrapped, WrapAction(factory))
+	}
+	return wrapped
+}
+
+// ReadOnlyAction is implemented by actions that only read from PingFederate, such as exporting a CSR, which can be
+// invoked when the provider is configured with read_only.
+type ReadOnlyAction interface {
+	action.Action
+	ReadOnly()
+}
+
+// WrapAction adds a timeouts block with an invoke timeout to an action. The action is invoked with a context deadline
+// from the timeout, which is propagated to the admin API calls. The wrapped action is unaware of the timeouts block,
+// which is removed from the config before it is passed to the wrapped action. When the provider is configured with
+// read_only, actions that don't implement ReadOnlyAction fail before the wrapped action is called.
+func WrapAction(factory func() action.Action) func() action.Action {
+	return func() action.Action {
+		return &wrappedAction{
+			inner: factory(),
+		}
+	}
+}
+
+type wrappedAction struct {
+	inner       action.Action
+	innerSchema *actionschema.Schema
+	typeName    string
+	readOnly    bool
+}
+
+func (a *wrappedAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	a.inner.Metadata(ctx, req, resp)
+	a.typeName = resp.TypeName
+}
+
+// Get the action type name, see wrappedResource.getTypeName
+func (a *wrappedAction) getTypeName(ctx context.Context) string {
+	if a.typeName == "" {
+		var metadataResp action.MetadataResponse
+		a.inner.Metadata(ctx, action.MetadataRequest{ProviderTypeName: providerTypeName}, &metadataResp)
+		a.typeName = metadataResp.TypeName
+	}
+	return a.typeName
+}
+
+func (a *wrappedAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	innerSchema := a.getInnerSchema(ctx, &resp.Diagnostics)
+	fullSchema := innerSchema
+	fullSchema.Blocks = make(map[string]actionschema.Block, len(innerSchema.Blocks)+1)
+	for name, block := range innerSchema.Blocks {
+		fullSchema.Blocks[name] = block
+	}
+	timeoutsBlock := timeouts.BlockWithOpts(ctx, timeouts.Opts{
+		InvokeDescription: timeoutDescription("invoking the action"),
+	})
+	if singleNestedBlock, ok := timeoutsBlock.(actionschema.SingleNestedBlock); ok {
+		singleNestedBlock.Description = "Timeouts for invoking the action, including any retries of failed requests to the PingFederate Admin API."
+		timeoutsBlock = singleNestedBlock
+	}
+	fullSchema.Blocks[AttributeName] = timeoutsBlock
+	resp.Schema = fullSchema
+}
+
+func (a *wrappedAction) getInnerSchema(ctx context.Context, diags *diag.Diagnostics) actionschema.Schema {
+	if a.innerSchema == nil {
+		var schemaResp action.SchemaResponse
+		a.inner.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+		diags.Append(schemaResp.Diagnostics...)
+		a.innerSchema = &schemaResp.Schema
+	}
+	return *a.innerSchema
+}
+
+func (a *wrappedAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if providerCfg, ok := req.ProviderData.(internaltypes.ResourceConfiguration); ok {
+		a.readOnly = providerCfg.ProviderConfig.ReadOnly
+	}
+	if inner, ok := a.inner.(action.ActionWithConfigure); ok {
+		inner.Configure(ctx, req, resp)
+	}
+}
+
+func (a *wrappedAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	if _, readsOnly := a.inner.(ReadOnlyAction); a.readOnly && !readsOnly {
+		addReadOnlyError(&resp.Diagnostics, "invoke", a.getTypeName(ctx))
+		return
+	}
+	var timeoutsValue timeouts.Value
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(AttributeName), &timeoutsValue)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	invokeTimeout, diags := timeoutsValue.Invoke(ctx, DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, invokeTimeout)
+	defer cancel()
+
+	innerSchema := a.getInnerSchema(ctx, &resp.Diagnostics)
+	innerReq := action.InvokeRequest{
+		Config: tfsdk.Config{
+			Schema: innerSchema,
+			Raw:    stripWrapperAttributes(ctx, innerSchema, req.Config.Raw, &resp.Diagnostics),
+		},
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	a.inner.Invoke(ctx, innerReq, resp)
+}
@@ -0,0 +1,93 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package resourcewrapper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+)
+
+// Adopt the existing object after the wrapped resource's create failed because an object with the same ID already
+// exists. The existing object is read using the ID attributes of the plan, in the same way as the read following
+// an import, so sensitive values that PingFederate doesn't return are handled correctly. It is then updated with the
+// planned configuration. If the existing object can't be read, the diagnostics of the failed create are kept.
+func (r *wrappedResource) adoptExisting(ctx context.Context, createReq resource.CreateRequest, createResp *resource.CreateResponse) {
+	if diags := importprivatestate.MarkPrivateState(ctx, createResp.Private); diags.HasError() {
+		createResp.Diagnostics.Append(diags...)
+		return
+	}
+
+	readReq := resource.ReadRequest{
+		State:        tfsdk.State{Schema: createReq.Plan.Schema, Raw: createReq.Plan.Raw},
+		Identity:     createResp.Identity,
+		Private:      createResp.Private,
+		ProviderMeta: createReq.ProviderMeta,
+	}
+	readResp := resource.ReadResponse{
+		State:    tfsdk.State{Schema: createReq.Plan.Schema, Raw: createReq.Plan.Raw},
+		Identity: createResp.Identity,
+		Private:  createResp.Private,
+	}
+	r.inner.Read(ctx, readReq, &readResp)
+	if readResp.Diagnostics.HasError() || readResp.State.Raw.IsNull() {
+		return
+	}
+
+	updateReq := resource.UpdateRequest{
+		Config:       createReq.Config,
+		Plan:         createReq.Plan,
+		State:        readResp.State,
+		Identity:     readResp.Identity,
+		ProviderMeta: createReq.ProviderMeta,
+		Private:      readResp.Private,
+	}
+	updateResp := resource.UpdateResponse{
+		State:    tfsdk.State{Schema: createReq.Plan.Schema, Raw: createReq.Plan.Raw},
+		Identity: readResp.Identity,
+		Private:  readResp.Private,
+	}
+	r.inner.Update(ctx, updateReq, &updateResp)
+
+	createResp.Diagnostics = updateResp.Diagnostics
+	if !updateResp.Diagnostics.HasError() {
+		createResp.Diagnostics.AddWarning(providererror.ExistingObjectAdopted,
+			fmt.Sprintf("An object with the same ID as this %s resource already exists in PingFederate. "+
+				"Because the provider is configured with adopt_existing_on_create set to true, the existing object has been adopted and updated with the planned configuration.", r.getTypeName(ctx)))
+	}
+	createResp.State = updateResp.State
+	createResp.Identity = updateResp.Identity
+	createResp.Private = updateResp.Private
+}
+
+// Whether the identifier attributes of the resource are all known in the plan. The existing object can only be found
+// when its ID is set in the configuration, so resources with no identifier attributes, and resources whose ID is
+// generated by PingFederate, are never adopted.
+func (r *wrappedResource) planIdentifiersKnown(ctx context.Context, plan tftypes.Value) bool {
+	inner, ok := r.inner.(resource.ResourceWithIdentity)
+	if !ok || !plan.IsKnown() || plan.IsNull() {
+		return false
+	}
+	var identityResp resource.IdentitySchemaResponse
+	inner.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+	if len(identityResp.IdentitySchema.Attributes) == 0 {
+		return false
+	}
+
+	var planAttrs map[string]tftypes.Value
+	if err := plan.As(&planAttrs); err != nil {
+		return false
+	}
+	for name := range identityResp.IdentitySchema.Attributes {
+		value, ok := planAttrs[name]
+		if !ok || !value.IsKnown() || value.IsNull() {
+			return false
+		}
+	}
+	return true
+}
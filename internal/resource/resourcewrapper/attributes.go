@@ -0,0 +1,93 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package resourcewrapper
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+)
+
+// Schema of a plan or state, which is only used to get its Terraform type
+type typedSchema interface {
+	Type() attr.Type
+}
+
+// Remove the attributes and blocks added by the wrapper, such as the timeouts block, from a plan, state, or config
+// value, so that it matches the wrapped resource or action's schema
+func stripWrapperAttributes(ctx context.Context, innerSchema typedSchema, raw tftypes.Value, diags *diag.Diagnostics) tftypes.Value {
+	innerType := innerSchema.Type().TerraformType(ctx)
+	if raw.IsNull() {
+		return tftypes.NewValue(innerType, nil)
+	}
+	if !raw.IsKnown() {
+		return tftypes.NewValue(innerType, tftypes.UnknownValue)
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := raw.As(&attrs); err != nil {
+		diags.AddError(providererror.InternalProviderError, "Failed to remove wrapper attributes from resource value: "+err.Error())
+		return tftypes.NewValue(innerType, nil)
+	}
+	// Copy the attributes, since the map returned by As is shared with the original value
+	innerAttrTypes := innerType.(tftypes.Object).AttributeTypes
+	innerAttrs := make(map[string]tftypes.Value, len(innerAttrTypes))
+	for name, value := range attrs {
+		if _, ok := innerAttrTypes[name]; ok {
+			innerAttrs[name] = value
+		}
+	}
+	return tftypes.NewValue(innerType, innerAttrs)
+}
+
+// Get the attributes of a plan or state value, from which the attributes added by the wrapper are taken when adding
+// them back to a value returned by the wrapped resource. Returns nil if the value is null or unknown.
+func wrapperAttributesRaw(ctx context.Context, fullSchema typedSchema, raw tftypes.Value, diags *diag.Diagnostics) map[string]tftypes.Value {
+	if raw.IsNull() || !raw.IsKnown() {
+		return nil
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := raw.As(&attrs); err != nil {
+		diags.AddError(providererror.InternalProviderError, "Failed to read wrapper attributes from resource value: "+err.Error())
+		return nil
+	}
+	return attrs
+}
+
+// Add the attributes and blocks added by the wrapper back to a plan or state value returned by the wrapped resource.
+// Any that aren't in wrapperAttrs are added as null.
+func addWrapperAttributes(ctx context.Context, fullSchema typedSchema, innerRaw tftypes.Value, wrapperAttrs map[string]tftypes.Value, diags *diag.Diagnostics) tftypes.Value {
+	fullType := fullSchema.Type().TerraformType(ctx)
+	if innerRaw.IsNull() {
+		return tftypes.NewValue(fullType, nil)
+	}
+	if !innerRaw.IsKnown() {
+		return tftypes.NewValue(fullType, tftypes.UnknownValue)
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := innerRaw.As(&attrs); err != nil {
+		diags.AddError(providererror.InternalProviderError, "Failed to add wrapper attributes to resource value: "+err.Error())
+		return tftypes.NewValue(fullType, nil)
+	}
+	fullAttrTypes := fullType.(tftypes.Object).AttributeTypes
+	fullAttrs := make(map[string]tftypes.Value, len(fullAttrTypes))
+	for name, value := range attrs {
+		fullAttrs[name] = value
+	}
+	for name, attrType := range fullAttrTypes {
+		if _, ok := fullAttrs[name]; ok {
+			continue
+		}
+		if value, ok := wrapperAttrs[name]; ok {
+			fullAttrs[name] = value
+		} else {
+			fullAttrs[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+	return tftypes.NewValue(fullType, fullAttrs)
+}
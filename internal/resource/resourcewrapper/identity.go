@@ -0,0 +1,106 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package resourcewrapper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+// Identity attribute of resources with no identifier attributes. There is only one instance of each of these
+// resources on a PingFederate server, so they are identified by the server.
+const HostIdentityAttributeName = "https_host"
+
+func (r *wrappedResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	if inner, ok := r.inner.(resource.ResourceWithIdentity); ok {
+		inner.IdentitySchema(ctx, req, resp)
+		return
+	}
+
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			HostIdentityAttributeName: identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "URI for the PingFederate HTTPS port of the server managed by this resource. There is only one instance of this resource on each server. If not specified on import, the `https_host` configured in the provider is used.",
+			},
+		},
+	}
+}
+
+// Whether the resource is identified by the PingFederate server rather than by its own identifier attributes
+func (r *wrappedResource) hasHostIdentity() bool {
+	_, ok := r.inner.(resource.ResourceWithIdentity)
+	return !ok
+}
+
+// Set the identity from the resource state. Resources that define their own identity schema are identified by the
+// state attributes with the same names as their identity attributes. The identity is only set if the wrapped
+// resource did not set it. If the resource was removed from state, the prior state is used instead, since the
+// identity can't be null after a read. Resources without their own identity schema are identified by the host.
+func (r *wrappedResource) setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, state, priorState tftypes.Value, diags *diag.Diagnostics) {
+	if identity == nil {
+		return
+	}
+	identityType, ok := identity.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		return
+	}
+
+	if r.hasHostIdentity() {
+		identity.Raw = tftypes.NewValue(identityType, map[string]tftypes.Value{
+			HostIdentityAttributeName: tftypes.NewValue(tftypes.String, r.httpsHost),
+		})
+		return
+	}
+
+	if !identity.Raw.IsFullyNull() {
+		return
+	}
+	if state.IsNull() || !state.IsKnown() {
+		state = priorState
+	}
+	if state.IsNull() || !state.IsKnown() {
+		return
+	}
+
+	var stateAttrs map[string]tftypes.Value
+	if err := state.As(&stateAttrs); err != nil {
+		diags.AddError(providererror.InternalProviderError, "Failed to read resource identity from state: "+err.Error())
+		return
+	}
+	identityAttrs := make(map[string]tftypes.Value, len(identityType.AttributeTypes))
+	for name, attrType := range identityType.AttributeTypes {
+		value, ok := stateAttrs[name]
+		if !ok || !value.IsKnown() {
+			value = tftypes.NewValue(attrType, nil)
+		}
+		identityAttrs[name] = value
+	}
+	identity.Raw = tftypes.NewValue(identityType, identityAttrs)
+}
+
+// Verify that a resource identified by the host is being imported from the server managed by the provider
+func (r *wrappedResource) validateImportIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, diags *diag.Diagnostics) {
+	if identity == nil || !r.hasHostIdentity() || identity.Raw.IsNull() {
+		return
+	}
+
+	var httpsHost types.String
+	diags.Append(identity.GetAttribute(ctx, path.Root(HostIdentityAttributeName), &httpsHost)...)
+	if internaltypes.IsDefined(httpsHost) && httpsHost.ValueString() != r.httpsHost {
+		diags.AddError(providererror.InvalidResourceIdForImport,
+			fmt.Sprintf("Unable to import %s, because the %s identity attribute \"%s\" does not match the https_host configured in the provider, \"%s\". "+
+				"Resources with no identifier attributes can only be imported from the PingFederate server managed by the provider.",
+				r.getTypeName(ctx), HostIdentityAttributeName, httpsHost.ValueString(), r.httpsHost))
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package resourcewrapper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+var (
+	_ list.ListResource              = &wrappedListResource{}
+	_ list.ListResourceWithConfigure = &wrappedListResource{}
+)
+
+// Number of instances requested in each page from collections that support paging
+const ListPageSize = 100
+
+// An instance of a resource returned by a collection GET endpoint
+type ListItem struct {
+	// Value of the single identity attribute of the resource
+	Id          string
+	DisplayName string
+}
+
+// Configuration of the list block of a list resource
+type ListConfig struct {
+	Filter types.String `tfsdk:"filter"`
+}
+
+// Get one page of the instances of a resource from PingFederate. Pages are numbered from 1. Collections that don't
+// support paging return every instance on the first page, and return lastPage as true.
+type ListPageFunc func(ctx context.Context, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration, listConfig ListConfig, page int64) (items []ListItem, lastPage bool, httpResp *http.Response, err error)
+
+// WrapList creates a list resource for a resource, to allow discovering existing instances with terraform query.
+// The list resource has the same type name as the resource. Instances are identified by the resource's single
+// identity attribute. When the resource is requested for each instance, such as when generating config, the
+// instance is imported by its identity and read with the resource, so the result matches a resource imported
+// with an import block. When filterDescription is not empty, the list block has a filter attribute, which is
+// passed to listPage.
+func WrapList(factory func() resource.Resource, listPage ListPageFunc, filterDescription string) list.ListResource {
+	return &wrappedListResource{
+		resource:          &wrappedResource{inner: factory()},
+		listPage:          listPage,
+		filterDescription: filterDescription,
+	}
+}
+
+type wrappedListResource struct {
+	resource          *wrappedResource
+	listPage          ListPageFunc
+	filterDescription string
+	providerConfig    internaltypes.ProviderConfiguration
+	apiClient         *client.APIClient
+}
+
+func (r *wrappedListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	r.resource.Metadata(ctx, req, resp)
+}
+
+func (r *wrappedListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{},
+	}
+	if r.filterDescription != "" {
+		resp.Schema.Attributes["filter"] = listschema.StringAttribute{
+			Optional:    true,
+			Description: r.filterDescription,
+		}
+	}
+}
+
+func (r *wrappedListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+	r.resource.Configure(ctx, req, resp)
+}
+
+func (r *wrappedListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+	var listConfig ListConfig
+	if r.filterDescription != "" {
+		diags.Append(req.Config.Get(ctx, &listConfig)...)
+	}
+	identityAttributes := req.ResourceIdentitySchema.GetAttributes()
+	if len(identityAttributes) != 1 {
+		diags.AddError(providererror.InternalProviderError,
+			fmt.Sprintf("Listing %s requires an identity with a single attribute, but the resource identity has %d attributes", r.resource.getTypeName(ctx), len(identityAttributes)))
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	var identityAttribute string
+	for name := range identityAttributes {
+		identityAttribute = name
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var pushed int64
+		for page := int64(1); ; page++ {
+			items, lastPage, httpResp, err := r.listPage(ctx, r.apiClient, r.providerConfig, listConfig, page)
+			if err != nil {
+				var errDiags diag.Diagnostics
+				config.ReportHttpError(ctx, &errDiags, fmt.Sprintf("An error occurred while listing %s", r.resource.getTypeName(ctx)), err, httpResp)
+				push(list.ListResult{Diagnostics: errDiags})
+				return
+			}
+
+			for _, item := range items {
+				result := req.NewListResult(ctx)
+				result.DisplayName = item.DisplayName
+				result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(identityAttribute), item.Id)...)
+				if req.IncludeResource && !result.Diagnostics.HasError() {
+					if !r.readResource(ctx, &result) {
+						// The instance was deleted after it was listed
+						continue
+					}
+				}
+				if !push(result) {
+					return
+				}
+				pushed++
+				if req.Limit > 0 && pushed >= req.Limit {
+					return
+				}
+			}
+
+			if lastPage || len(items) == 0 {
+				return
+			}
+		}
+	}
+}
+
+// Read a listed instance into the result, in the same way as importing it by its identity. Returns false if the
+// instance no longer exists.
+func (r *wrappedListResource) readResource(ctx context.Context, result *list.ListResult) bool {
+	importResp := resource.ImportStateResponse{
+		State:    tfsdk.State{Schema: result.Resource.Schema, Raw: result.Resource.Raw.Copy()},
+		Identity: &tfsdk.ResourceIdentity{Schema: result.Identity.Schema, Raw: result.Identity.Raw.Copy()},
+	}
+	importResp.Private = newPrivateState(importResp.Private)
+	if _, ok := r.resource.inner.(resource.ResourceWithImportState); ok {
+		r.resource.ImportState(ctx, resource.ImportStateRequest{Identity: result.Identity}, &importResp)
+	} else {
+		// Resources that can't be imported are read with just their identity attributes in state
+		for name := range result.Identity.Schema.GetAttributes() {
+			var value types.String
+			importResp.Diagnostics.Append(result.Identity.GetAttribute(ctx, path.Root(name), &value)...)
+			importResp.Diagnostics.Append(importResp.State.SetAttribute(ctx, path.Root(name), value)...)
+		}
+	}
+	result.Diagnostics.Append(importResp.Diagnostics...)
+	if result.Diagnostics.HasError() {
+		return true
+	}
+
+	readReq := resource.ReadRequest{
+		State:    importResp.State,
+		Identity: importResp.Identity,
+		Private:  importResp.Private,
+	}
+	readResp := resource.ReadResponse{
+		State:    tfsdk.State{Schema: importResp.State.Schema, Raw: importResp.State.Raw.Copy()},
+		Identity: &tfsdk.ResourceIdentity{Schema: importResp.Identity.Schema, Raw: importResp.Identity.Raw.Copy()},
+		Private:  importResp.Private,
+	}
+	r.resource.Read(ctx, readReq, &readResp)
+	result.Diagnostics.Append(readResp.Diagnostics...)
+	if readResp.State.Raw.IsNull() {
+		return false
+	}
+	result.Resource.Raw = readResp.State.Raw
+	result.Identity = readResp.Identity
+	return true
+}
+
+// Create empty private state data for a resource. The private state type is internal to the framework, so it can
+// only be created by inferring it from an existing pointer.
+func newPrivateState[T any](_ *T) *T {
+	return new(T)
+}
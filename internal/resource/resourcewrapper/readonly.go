@@ -0,0 +1,17 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package resourcewrapper
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+)
+
+func addReadOnlyError(diags *diag.Diagnostics, operation, typeName string) {
+	diags.AddError(providererror.ReadOnlyModeError,
+		fmt.Sprintf("Unable to %s %s, because the provider is configured with read_only set to true. "+
+			"No changes were made to PingFederate. Set read_only to false, or unset the PINGFEDERATE_PROVIDER_READ_ONLY environment variable, to allow changes.",
+			operation, typeName))
+}
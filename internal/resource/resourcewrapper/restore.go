@@ -0,0 +1,163 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package resourcewrapper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+)
+
+const (
+	RestoreOnDestroyAttributeName = "restore_on_destroy"
+	// Private state keys for the configuration on the server before the resource was created, and the configuration
+	// on the server after Terraform last applied the resource
+	originalConfigurationKey = "restore_on_destroy_original"
+	appliedConfigurationKey  = "restore_on_destroy_applied"
+)
+
+// ResourceWithRestoreOnDestroy is implemented by singleton resources that update an existing configuration object,
+// which can't be deleted from the server. The configuration on the server before the resource is created is kept in
+// private state, and is put back on destroy when the resource's restore_on_destroy attribute is set to true.
+type ResourceWithRestoreOnDestroy interface {
+	resource.Resource
+	// ReadConfiguration returns the JSON of the configuration object currently on the server
+	ReadConfiguration(ctx context.Context) ([]byte, diag.Diagnostics)
+	// RestoreConfiguration updates the configuration object on the server with JSON returned by ReadConfiguration
+	RestoreConfiguration(ctx context.Context, configuration []byte) diag.Diagnostics
+}
+
+// Private state of a request or response
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+func withRestoreOnDestroy(fullSchema schema.Schema, typeName string) schema.Schema {
+	attributes := make(map[string]schema.Attribute, len(fullSchema.Attributes)+1)
+	for name, attribute := range fullSchema.Attributes {
+		attributes[name] = attribute
+	}
+	attributes[RestoreOnDestroyAttributeName] = schema.BoolAttribute{
+		Description: fmt.Sprintf("Set to `true` to return the configuration on the PingFederate server to what it was before the %s resource was created, when the resource is destroyed. "+
+			"The original configuration is read from the server when the resource is created, and stored in the private state of the resource. "+
+			"Resources that were imported, or created by earlier versions of the provider, have no original configuration to return to. "+
+			"If the configuration was changed outside of Terraform since it was last applied, a warning lists the changed fields. The default value is `false`.", typeName),
+		Optional: true,
+	}
+	fullSchema.Attributes = attributes
+	return fullSchema
+}
+
+// Check if the restore_on_destroy attribute of a plan or state is set to true
+func isRestoreOnDestroy(ctx context.Context, getAttribute func(context.Context, path.Path, interface{}) diag.Diagnostics, diags *diag.Diagnostics) bool {
+	var restoreOnDestroy types.Bool
+	diags.Append(getAttribute(ctx, path.Root(RestoreOnDestroyAttributeName), &restoreOnDestroy)...)
+	return restoreOnDestroy.ValueBool()
+}
+
+// Read the configuration on the server into private state after it has been applied, so changes made outside of
+// Terraform can be detected when restoring. The configuration is only kept while restore_on_destroy is enabled.
+func saveAppliedConfiguration(ctx context.Context, inner ResourceWithRestoreOnDestroy, restoreOnDestroy bool, private privateStateSetter, diags *diag.Diagnostics) {
+	if !restoreOnDestroy {
+		diags.Append(private.SetKey(ctx, appliedConfigurationKey, nil)...)
+		return
+	}
+	applied, readDiags := inner.ReadConfiguration(ctx)
+	diags.Append(readDiags...)
+	if readDiags.HasError() {
+		return
+	}
+	diags.Append(private.SetKey(ctx, appliedConfigurationKey, applied)...)
+}
+
+// Put the original configuration from private state back on the server. Returns false if there is no original
+// configuration to restore, in which case the wrapped resource's Delete is used instead.
+func restoreOriginalConfiguration(ctx context.Context, inner ResourceWithRestoreOnDestroy, typeName string, private privateStateGetter, diags *diag.Diagnostics) bool {
+	original, getDiags := private.GetKey(ctx, originalConfigurationKey)
+	diags.Append(getDiags...)
+	if getDiags.HasError() {
+		return false
+	}
+	if original == nil {
+		diags.AddWarning(providererror.ConfigurationCannotBeResetError,
+			fmt.Sprintf("The %s resource has %s set to true, but its original configuration was not captured when it was created. "+
+				"This happens when the resource was imported, or created by an earlier version of the provider.", typeName, RestoreOnDestroyAttributeName))
+		return false
+	}
+
+	applied, getDiags := private.GetKey(ctx, appliedConfigurationKey)
+	diags.Append(getDiags...)
+	if applied != nil {
+		current, readDiags := inner.ReadConfiguration(ctx)
+		diags.Append(readDiags...)
+		if readDiags.HasError() {
+			return true
+		}
+		if changedFields := configurationDifferences(applied, current, diags); len(changedFields) > 0 {
+			diags.AddWarning(providererror.ConfigurationChangedBeforeReset,
+				fmt.Sprintf("The configuration of the %s resource was changed outside of Terraform since it was last applied. "+
+					"These changes have been overwritten by the original configuration. Changed PingFederate fields: %s", typeName, strings.Join(changedFields, ", ")))
+		}
+	}
+
+	diags.Append(inner.RestoreConfiguration(ctx, original)...)
+	return true
+}
+
+// Get the PingFederate field paths that differ between two JSON configurations
+func configurationDifferences(before, after []byte, diags *diag.Diagnostics) []string {
+	var beforeValue, afterValue interface{}
+	if err := json.Unmarshal(before, &beforeValue); err != nil {
+		diags.AddError(providererror.InternalProviderError, "Failed to read the applied configuration from private state: "+err.Error())
+		return nil
+	}
+	if err := json.Unmarshal(after, &afterValue); err != nil {
+		diags.AddError(providererror.InternalProviderError, "Failed to read the current configuration: "+err.Error())
+		return nil
+	}
+	var differences []string
+	addDifferences("", beforeValue, afterValue, &differences)
+	sort.Strings(differences)
+	return differences
+}
+
+func addDifferences(fieldPath string, before, after interface{}, differences *[]string) {
+	beforeObject, beforeIsObject := before.(map[string]interface{})
+	afterObject, afterIsObject := after.(map[string]interface{})
+	if !beforeIsObject || !afterIsObject {
+		// Lists and values are compared as a whole
+		if !reflect.DeepEqual(before, after) {
+			*differences = append(*differences, fieldPath)
+		}
+		return
+	}
+
+	fieldNames := map[string]bool{}
+	for name := range beforeObject {
+		fieldNames[name] = true
+	}
+	for name := range afterObject {
+		fieldNames[name] = true
+	}
+	for name := range fieldNames {
+		childPath := name
+		if fieldPath != "" {
+			childPath = fieldPath + "." + name
+		}
+		addDifferences(childPath, beforeObject[name], afterObject[name], differences)
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package resourcewrapper
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+)
+
+func TestConfigurationDifferences(t *testing.T) {
+	testCases := []struct {
+		name        string
+		before      string
+		after       string
+		expected    []string
+		expectError bool
+	}{
+		{
+			name:   "equal configurations",
+			before: `{"enabled":true,"settings":{"timeout":30,"hosts":["a","b"]}}`,
+			after:  `{"settings":{"hosts":["a","b"],"timeout":30},"enabled":true}`,
+		},
+		{
+			name:     "changed values in nested objects",
+			before:   `{"enabled":true,"settings":{"timeout":30,"logging":{"level":"INFO"}}}`,
+			after:    `{"enabled":false,"settings":{"timeout":30,"logging":{"level":"DEBUG"}}}`,
+			expected: []string{"enabled", "settings.logging.level"},
+		},
+		{
+			name:     "lists compared as a whole",
+			before:   `{"hosts":["a","b"],"rules":[{"name":"r1","value":1}]}`,
+			after:    `{"hosts":["b","a"],"rules":[{"name":"r1","value":2}]}`,
+			expected: []string{"hosts", "rules"},
+		},
+		{
+			name:     "added and removed keys",
+			before:   `{"settings":{"timeout":30,"removed":"x"}}`,
+			after:    `{"settings":{"timeout":30},"added":{"value":1}}`,
+			expected: []string{"added", "settings.removed"},
+		},
+		{
+			name:     "object replaced by a value",
+			before:   `{"settings":{"timeout":30}}`,
+			after:    `{"settings":null}`,
+			expected: []string{"settings"},
+		},
+		{
+			name:        "invalid JSON",
+			before:      `{"enabled":true}`,
+			after:       `not json`,
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			differences := configurationDifferences([]byte(testCase.before), []byte(testCase.after), &diags)
+			if diags.HasError() != testCase.expectError {
+				t.Fatalf("expected error %t, got %v", testCase.expectError, diags)
+			}
+			if !reflect.DeepEqual(differences, testCase.expected) {
+				t.Errorf("expected differences %v, got %v", testCase.expected, differences)
+			}
+		})
+	}
+}
+
+// Private state for testing, keyed by private state key
+type testPrivateState map[string][]byte
+
+func (p testPrivateState) GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+// Singleton resource for testing, with its configuration on the server held in memory
+type testRestoreResource struct {
+	configuration []byte
+	restored      []byte
+	deleted       bool
+}
+
+func (r *testRestoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_test_settings"
+}
+
+func (r *testRestoreResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{Optional: true},
+		},
+	}
+}
+
+func (r *testRestoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+}
+
+func (r *testRestoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+func (r *testRestoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+func (r *testRestoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.deleted = true
+}
+
+func (r *testRestoreResource) ReadConfiguration(ctx context.Context) ([]byte, diag.Diagnostics) {
+	return r.configuration, nil
+}
+
+func (r *testRestoreResource) RestoreConfiguration(ctx context.Context, configuration []byte) diag.Diagnostics {
+	r.restored = configuration
+	return nil
+}
+
+func TestRestoreOriginalConfiguration(t *testing.T) {
+	ctx := context.Background()
+	original := []byte(`{"enabled":false}`)
+	applied := []byte(`{"enabled":true,"timeout":30}`)
+
+	testCases := []struct {
+		name             string
+		private          testPrivateState
+		current          []byte
+		expectedRestored bool
+		expectedWarning  string
+	}{
+		{
+			name:             "unchanged since applied",
+			private:          testPrivateState{originalConfigurationKey: original, appliedConfigurationKey: applied},
+			current:          applied,
+			expectedRestored: true,
+		},
+		{
+			name:             "changed since applied",
+			private:          testPrivateState{originalConfigurationKey: original, appliedConfigurationKey: applied},
+			current:          []byte(`{"enabled":true,"timeout":60}`),
+			expectedRestored: true,
+			expectedWarning:  providererror.ConfigurationChangedBeforeReset,
+		},
+		{
+			name:             "no applied configuration",
+			private:          testPrivateState{originalConfigurationKey: original},
+			current:          []byte(`{"enabled":true,"timeout":60}`),
+			expectedRestored: true,
+		},
+		{
+			name:            "no original configuration",
+			private:         testPrivateState{appliedConfigurationKey: applied},
+			current:         applied,
+			expectedWarning: providererror.ConfigurationCannotBeResetError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			inner := &testRestoreResource{configuration: testCase.current}
+			var diags diag.Diagnostics
+			restored := restoreOriginalConfiguration(ctx, inner, "pingfederate_test_settings", testCase.private, &diags)
+			if restored != testCase.expectedRestored {
+				t.Fatalf("expected restored %t, got %t", testCase.expectedRestored, restored)
+			}
+			if testCase.expectedRestored && string(inner.restored) != string(original) {
+				t.Errorf("expected the original configuration to be restored, got %s", inner.restored)
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags)
+			}
+			expectedWarnings := 0
+			if testCase.expectedWarning != "" {
+				expectedWarnings = 1
+			}
+			if diags.WarningsCount() != expectedWarnings || (expectedWarnings == 1 && diags.Warnings()[0].Summary() != testCase.expectedWarning) {
+				t.Errorf("expected warning '%s', got %v", testCase.expectedWarning, diags)
+			}
+		})
+	}
+}
+
+func TestDeleteWithoutOriginalConfiguration(t *testing.T) {
+	ctx := context.Background()
+	inner := &testRestoreResource{}
+	wrapped := &wrappedResource{inner: inner}
+	var schemaResp resource.SchemaResponse
+	wrapped.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", schemaResp.Diagnostics)
+	}
+
+	stateType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	stateAttrs := map[string]tftypes.Value{}
+	for name, attrType := range stateType.AttributeTypes {
+		stateAttrs[name] = tftypes.NewValue(attrType, nil)
+	}
+	stateAttrs["enabled"] = tftypes.NewValue(tftypes.Bool, true)
+	stateAttrs[RestoreOnDestroyAttributeName] = tftypes.NewValue(tftypes.Bool, true)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(stateType, stateAttrs)}
+
+	// The private state of a resource that was imported has no original configuration
+	req := resource.DeleteRequest{State: state}
+	resp := resource.DeleteResponse{State: state}
+	wrapped.Delete(ctx, req, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+	if resp.Diagnostics.WarningsCount() != 1 || resp.Diagnostics.Warnings()[0].Summary() != providererror.ConfigurationCannotBeResetError {
+		t.Errorf("expected a warning that the configuration can't be restored, got %v", resp.Diagnostics)
+	}
+	if !inner.deleted {
+		t.Error("expected the wrapped resource's Delete to be called")
+	}
+	if inner.restored != nil {
+		t.Errorf("expected no configuration to be restored, got %s", inner.restored)
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package resourcewrapper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+const (
+	AttributeName = "timeouts"
+	// Timeout used for each operation when no value is configured in the timeouts block
+	DefaultTimeout = 20 * time.Minute
+)
+
+func withTimeouts(ctx context.Context, innerSchema schema.Schema) schema.Schema {
+	fullSchema := innerSchema
+	fullSchema.Blocks = make(map[string]schema.Block, len(innerSchema.Blocks)+1)
+	for name, block := range innerSchema.Blocks {
+		fullSchema.Blocks[name] = block
+	}
+	timeoutsBlock := timeouts.Block(ctx, timeouts.Opts{
+		Create:            true,
+		CreateDescription: timeoutDescription("creating the resource"),
+		Read:              true,
+		ReadDescription:   timeoutDescription("reading the resource, which occurs during any refresh or planning operation when refresh is enabled"),
+		Update:            true,
+		UpdateDescription: timeoutDescription("updating the resource"),
+		Delete:            true,
+		DeleteDescription: timeoutDescription("deleting the resource"),
+	})
+	if singleNestedBlock, ok := timeoutsBlock.(schema.SingleNestedBlock); ok {
+		singleNestedBlock.Description = "Timeouts for each operation on the resource, including any retries of failed requests to the PingFederate Admin API."
+		timeoutsBlock = singleNestedBlock
+	}
+	fullSchema.Blocks[AttributeName] = timeoutsBlock
+	return fullSchema
+}
+
+func timeoutDescription(operation string) string {
+	return fmt.Sprintf("The maximum time allowed for %s. A string that can be [parsed as a duration](https://pkg.go.dev/time#ParseDuration), such as `30s` or `2h45m`. Valid time units are `s` (seconds), `m` (minutes), and `h` (hours). If no value is supplied, the value used will be `%s`.",
+		operation, strings.TrimSuffix(DefaultTimeout.String(), "0s"))
+}
+
+// Get the timeouts block value from a plan or state
+func getTimeouts(ctx context.Context, getAttribute func(context.Context, path.Path, interface{}) diag.Diagnostics, diags *diag.Diagnostics) timeouts.Value {
+	var timeoutsValue timeouts.Value
+	diags.Append(getAttribute(ctx, path.Root(AttributeName), &timeoutsValue)...)
+	return timeoutsValue
+}
@@ -0,0 +1,88 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package resourcewrapper
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/version"
+)
+
+// ResourceWithVersionRequirements is implemented by resources with attributes that are only supported by some
+// PingFederate versions. When planning, any of these attributes that is set in the config is checked against the
+// PingFederate version of the provider.
+type ResourceWithVersionRequirements interface {
+	resource.Resource
+	VersionRequirements() []version.AttributeRequirement
+}
+
+// Add an error for each attribute set in the config that isn't supported by the given PingFederate version
+func checkVersionRequirements(config tftypes.Value, productVersion version.SupportedVersion, requirements []version.AttributeRequirement, diags *diag.Diagnostics) {
+	if len(requirements) == 0 || productVersion == "" || config.IsNull() || !config.IsKnown() {
+		return
+	}
+
+	requirementsByPath := map[string]version.AttributeRequirement{}
+	for _, requirement := range requirements {
+		requirementsByPath[requirement.Path] = requirement
+	}
+
+	err := tftypes.Walk(config, func(p *tftypes.AttributePath, value tftypes.Value) (bool, error) {
+		if len(p.Steps()) == 0 {
+			return true, nil
+		}
+		// Nothing is set within a null value
+		if value.IsNull() {
+			return false, nil
+		}
+		if _, ok := p.LastStep().(tftypes.AttributeName); !ok {
+			return true, nil
+		}
+		attrPath, name := toAttributePath(p)
+		if requirement, ok := requirementsByPath[name]; ok {
+			requirement.Check(attrPath, productVersion, diags)
+		}
+		return true, nil
+	})
+	if err != nil {
+		diags.AddError(providererror.InternalProviderError, "Failed to check the PingFederate version required by the resource config: "+err.Error())
+	}
+}
+
+// Convert a terraform attribute path to a framework path, and to the dot-separated attribute names used by
+// version.AttributeRequirement. Set elements can't be addressed by a framework path without their value, so the
+// framework path of an attribute within a set ends at the set.
+func toAttributePath(p *tftypes.AttributePath) (path.Path, string) {
+	var result path.Path
+	var names []string
+	inSet := false
+	for _, step := range p.Steps() {
+		switch s := step.(type) {
+		case tftypes.AttributeName:
+			names = append(names, string(s))
+			if !inSet {
+				if len(result.Steps()) == 0 {
+					result = path.Root(string(s))
+				} else {
+					result = result.AtName(string(s))
+				}
+			}
+		case tftypes.ElementKeyInt:
+			if !inSet {
+				result = result.AtListIndex(int(s))
+			}
+		case tftypes.ElementKeyString:
+			if !inSet {
+				result = result.AtMapKey(string(s))
+			}
+		case tftypes.ElementKeyValue:
+			inSet = true
+		}
+	}
+	return result, strings.Join(names, ".")
+}
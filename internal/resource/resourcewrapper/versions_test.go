@@ -0,0 +1,177 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package resourcewrapper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/version"
+)
+
+func TestToAttributePath(t *testing.T) {
+	testCases := []struct {
+		name          string
+		attributePath *tftypes.AttributePath
+		expectedPath  path.Path
+		expectedName  string
+	}{
+		{
+			name:          "root attribute",
+			attributePath: tftypes.NewAttributePath().WithAttributeName("name"),
+			expectedPath:  path.Root("name"),
+			expectedName:  "name",
+		},
+		{
+			name:          "attribute in a list",
+			attributePath: tftypes.NewAttributePath().WithAttributeName("list").WithElementKeyInt(1).WithAttributeName("new_attr"),
+			expectedPath:  path.Root("list").AtListIndex(1).AtName("new_attr"),
+			expectedName:  "list.new_attr",
+		},
+		{
+			name:          "attribute in a map",
+			attributePath: tftypes.NewAttributePath().WithAttributeName("map").WithElementKeyString("key").WithAttributeName("new_attr"),
+			expectedPath:  path.Root("map").AtMapKey("key").AtName("new_attr"),
+			expectedName:  "map.new_attr",
+		},
+		{
+			name:          "attribute in a set stops at the set",
+			attributePath: tftypes.NewAttributePath().WithAttributeName("single").WithAttributeName("set").WithElementKeyValue(tftypes.NewValue(tftypes.String, "a")).WithAttributeName("new_attr"),
+			expectedPath:  path.Root("single").AtName("set"),
+			expectedName:  "single.set.new_attr",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, name := toAttributePath(testCase.attributePath)
+			if !result.Equal(testCase.expectedPath) {
+				t.Errorf("expected path %s, got %s", testCase.expectedPath, result)
+			}
+			if name != testCase.expectedName {
+				t.Errorf("expected name %s, got %s", testCase.expectedName, name)
+			}
+		})
+	}
+}
+
+func TestCheckVersionRequirements(t *testing.T) {
+	ctx := context.Background()
+	nestedObject := schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"name":     schema.StringAttribute{Required: true},
+			"new_attr": schema.StringAttribute{Optional: true},
+		},
+	}
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"new_attr": schema.StringAttribute{Optional: true},
+			"old_attr": schema.StringAttribute{Optional: true},
+			"list":     schema.ListNestedAttribute{Optional: true, NestedObject: nestedObject},
+			"set":      schema.SetNestedAttribute{Optional: true, NestedObject: nestedObject},
+			"map":      schema.MapNestedAttribute{Optional: true, NestedObject: nestedObject},
+		},
+	}
+	objectType := testSchema.Type().TerraformType(ctx).(tftypes.Object)
+	elementType := objectType.AttributeTypes["list"].(tftypes.List).ElementType
+	element := func(newAttr interface{}) tftypes.Value {
+		return tftypes.NewValue(elementType, map[string]tftypes.Value{
+			"name":     tftypes.NewValue(tftypes.String, "element"),
+			"new_attr": tftypes.NewValue(tftypes.String, newAttr),
+		})
+	}
+	config := func(newAttr, oldAttr interface{}, list, set []tftypes.Value, elements map[string]tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"new_attr": tftypes.NewValue(tftypes.String, newAttr),
+			"old_attr": tftypes.NewValue(tftypes.String, oldAttr),
+			"list":     tftypes.NewValue(objectType.AttributeTypes["list"], list),
+			"set":      tftypes.NewValue(objectType.AttributeTypes["set"], set),
+			"map":      tftypes.NewValue(objectType.AttributeTypes["map"], elements),
+		})
+	}
+	requirements := []version.AttributeRequirement{
+		{Path: "new_attr", Minimum: version.PingFederate1300},
+		{Path: "old_attr", Maximum: version.PingFederate1236},
+		{Path: "list.new_attr", Minimum: version.PingFederate1300},
+		{Path: "set.new_attr", Minimum: version.PingFederate1300},
+		{Path: "map.new_attr", Minimum: version.PingFederate1300},
+	}
+
+	testCases := []struct {
+		name           string
+		config         tftypes.Value
+		productVersion version.SupportedVersion
+		expectedPaths  []path.Path
+	}{
+		{
+			name:           "supported attributes",
+			config:         config("value", nil, []tftypes.Value{element("value")}, nil, nil),
+			productVersion: version.PingFederate1300,
+		},
+		{
+			name:           "unsupported root attributes",
+			config:         config("value", "value", nil, nil, nil),
+			productVersion: version.PingFederate1300,
+			expectedPaths:  []path.Path{path.Root("old_attr")},
+		},
+		{
+			name:           "unsupported nested attributes",
+			config:         config(nil, nil, []tftypes.Value{element(nil), element("value")}, []tftypes.Value{element("value")}, map[string]tftypes.Value{"key": element("value")}),
+			productVersion: version.PingFederate1236,
+			expectedPaths: []path.Path{
+				path.Root("list").AtListIndex(1).AtName("new_attr"),
+				path.Root("set"),
+				path.Root("map").AtMapKey("key").AtName("new_attr"),
+			},
+		},
+		{
+			name:           "null attributes",
+			config:         config(nil, nil, nil, nil, nil),
+			productVersion: version.PingFederate1220,
+		},
+		{
+			name:           "unknown attributes",
+			config:         config(tftypes.UnknownValue, nil, []tftypes.Value{element(tftypes.UnknownValue)}, nil, nil),
+			productVersion: version.PingFederate1220,
+			expectedPaths: []path.Path{
+				path.Root("new_attr"),
+				path.Root("list").AtListIndex(0).AtName("new_attr"),
+			},
+		},
+		{
+			name:           "unknown config",
+			config:         tftypes.NewValue(objectType, tftypes.UnknownValue),
+			productVersion: version.PingFederate1220,
+		},
+		{
+			name:           "no product version",
+			config:         config("value", nil, nil, nil, nil),
+			productVersion: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			checkVersionRequirements(testCase.config, testCase.productVersion, requirements, &diags)
+			if diags.ErrorsCount() != len(testCase.expectedPaths) {
+				t.Fatalf("expected %d errors, got %v", len(testCase.expectedPaths), diags)
+			}
+			for _, expectedPath := range testCase.expectedPaths {
+				found := false
+				for _, d := range diags {
+					if withPath, ok := d.(diag.DiagnosticWithPath); ok && withPath.Path().Equal(expectedPath) {
+						found = true
+					}
+				}
+				if !found {
+					t.Errorf("expected an error at %s, got %v", expectedPath, diags)
+				}
+			}
+		})
+	}
+}
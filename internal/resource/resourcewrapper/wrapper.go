@@ -0,0 +1,484 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package resourcewrapper
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/api"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/fieldpath"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/version"
+)
+
+var (
+	_ resource.Resource                     = &wrappedResource{}
+	_ resource.ResourceWithConfigure        = &wrappedResource{}
+	_ resource.ResourceWithImportState      = &wrappedResource{}
+	_ resource.ResourceWithModifyPlan       = &wrappedResource{}
+	_ resource.ResourceWithValidateConfig   = &wrappedResource{}
+	_ resource.ResourceWithConfigValidators = &wrappedResource{}
+	_ resource.ResourceWithUpgradeState     = &wrappedResource{}
+	_ resource.ResourceWithMoveState        = &wrappedResource{}
+	_ resource.ResourceWithIdentity         = &wrappedResource{}
+)
+
+// Type name of the provider, which prefixes the type name of each resource
+const providerTypeName = "pingfederate"
+
+// WrapAll applies Wrap to each resource.
+func WrapAll(factories []func() resource.Resource) []func() resource.Resource {
+	wrapped := make([]func() resource.Resource, 0, len(factories))
+	for _, factory := range factories {
+		wrapped = append(wrapped, Wrap(factory))
+	}
+	return wrapped
+}
+
+// Wrap adds behavior shared by all resources to a resource:
+//   - A timeouts block with create, read, update, and delete timeouts. Each operation runs with a context deadline
+//     from the corresponding timeout, which is propagated to the admin API calls. The wrapped resource is unaware
+//     of the timeouts block, which is removed from the plan, state, and config before they are passed to the
+//     wrapped resource, and added back to the resulting plan and state.
+//   - Read-only mode. When the provider is configured with read_only, create, update, and delete fail before
+//     the wrapped resource is called.
+//   - Write-only values. The plan passed to create and update includes the config value of each write-only
+//     attribute, so wrapped resources can build requests from write-only attributes in the plan.
+//   - Resource identity. Resources that define an identity schema have their identity set from the state attributes
+//     with the same names after each operation, unless they set it themselves. Resources that don't define one,
+//     which have no identifier attributes, are identified by the https_host of the PingFederate server.
+//   - Validation error paths. The field paths of PingFederate validation errors reported by the wrapped resource are
+//     mapped onto the attributes of its schema, so the errors are shown on the attribute that caused them.
+//   - Restore on destroy. Resources that implement ResourceWithRestoreOnDestroy have a restore_on_destroy attribute,
+//     and the configuration on the server before they were created is put back when they are destroyed.
+//   - Adopting existing objects. When the provider is configured with adopt_existing_on_create and create fails
+//     because an object with the same ID already exists, the existing object is read as if it had been imported and
+//     updated with the planned configuration, with a warning instead of an error. Objects are only adopted when the
+//     identifier attributes of the resource are known in the plan.
+//   - Version requirements. Resources that implement ResourceWithVersionRequirements have each attribute set in
+//     the config checked against the PingFederate version of the provider when planning.
+func Wrap(factory func() resource.Resource) func() resource.Resource {
+	return func() resource.Resource {
+		return &wrappedResource{
+			inner: factory(),
+		}
+	}
+}
+
+type wrappedResource struct {
+	inner          resource.Resource
+	innerSchema    *schema.Schema
+	typeName       string
+	readOnly       bool
+	adoptOnCreate  bool
+	httpsHost      string
+	productVersion version.SupportedVersion
+}
+
+func (r *wrappedResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	r.inner.Metadata(ctx, req, resp)
+	r.typeName = resp.TypeName
+	// The host of resources identified by the server changes when the provider's https_host changes
+	if r.hasHostIdentity() {
+		resp.ResourceBehavior.MutableIdentity = true
+	}
+}
+
+// Get the resource type name. The framework only calls Metadata on the instance of each resource used to list the
+// provider's resources, so the type name isn't set on the instances that handle other operations.
+func (r *wrappedResource) getTypeName(ctx context.Context) string {
+	if r.typeName == "" {
+		var metadataResp resource.MetadataResponse
+		r.inner.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: providerTypeName}, &metadataResp)
+		r.typeName = metadataResp.TypeName
+	}
+	return r.typeName
+}
+
+func (r *wrappedResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	innerSchema := r.getInnerSchema(ctx, &resp.Diagnostics)
+	resp.Schema = withTimeouts(ctx, innerSchema)
+	if _, ok := r.inner.(ResourceWithRestoreOnDestroy); ok {
+		resp.Schema = withRestoreOnDestroy(resp.Schema, r.getTypeName(ctx))
+	}
+}
+
+func (r *wrappedResource) getInnerSchema(ctx context.Context, diags *diag.Diagnostics) schema.Schema {
+	if r.innerSchema == nil {
+		var schemaResp resource.SchemaResponse
+		r.inner.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+		diags.Append(schemaResp.Diagnostics...)
+		r.innerSchema = &schemaResp.Schema
+	}
+	return *r.innerSchema
+}
+
+func (r *wrappedResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if providerCfg, ok := req.ProviderData.(internaltypes.ResourceConfiguration); ok {
+		r.readOnly = providerCfg.ProviderConfig.ReadOnly
+		r.adoptOnCreate = providerCfg.ProviderConfig.AdoptExistingOnCreate
+		r.httpsHost = providerCfg.ProviderConfig.HttpsHost
+		r.productVersion = providerCfg.ProviderConfig.ProductVersion
+	}
+	if inner, ok := r.inner.(resource.ResourceWithConfigure); ok {
+		inner.Configure(ctx, req, resp)
+	}
+}
+
+func (r *wrappedResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.readOnly {
+		addReadOnlyError(&resp.Diagnostics, "create", r.getTypeName(ctx))
+		return
+	}
+	innerSchema := r.getInnerSchema(ctx, &resp.Diagnostics)
+	timeoutsValue := getTimeouts(ctx, req.Plan.GetAttribute, &resp.Diagnostics)
+	createTimeout, diags := timeoutsValue.Create(ctx, DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Capture the configuration on the server before it is changed, so that it can be restored on destroy
+	restorer, canRestore := r.inner.(ResourceWithRestoreOnDestroy)
+	var originalConfiguration []byte
+	if canRestore {
+		originalConfiguration, diags = restorer.ReadConfiguration(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	innerConfig := stripWrapperAttributes(ctx, innerSchema, req.Config.Raw, &resp.Diagnostics)
+	innerPlan := withWriteOnlyValues(ctx, innerSchema, stripWrapperAttributes(ctx, innerSchema, req.Plan.Raw, &resp.Diagnostics), innerConfig, &resp.Diagnostics)
+	innerReq := resource.CreateRequest{
+		Config:       tfsdk.Config{Schema: innerSchema, Raw: innerConfig},
+		Plan:         tfsdk.Plan{Schema: innerSchema, Raw: innerPlan},
+		Identity:     req.Identity,
+		ProviderMeta: req.ProviderMeta,
+	}
+	innerResp := resource.CreateResponse{
+		State:    tfsdk.State{Schema: innerSchema, Raw: stripWrapperAttributes(ctx, innerSchema, resp.State.Raw, &resp.Diagnostics)},
+		Identity: resp.Identity,
+		Private:  resp.Private,
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Detect when the create fails because the object already exists, so that the existing object can be adopted
+	innerCtx := ctx
+	var createConflict *api.CreateConflict
+	if r.adoptOnCreate && r.planIdentifiersKnown(ctx, innerPlan) {
+		innerCtx, createConflict = api.WithCreateConflictDetection(ctx)
+	}
+	r.inner.Create(innerCtx, innerReq, &innerResp)
+	if innerResp.Diagnostics.HasError() && createConflict.Detected() {
+		r.adoptExisting(ctx, innerReq, &innerResp)
+	}
+	resp.Diagnostics.Append(fieldpath.MapDiagnostics(innerSchema, innerResp.Diagnostics)...)
+	if canRestore && !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(innerResp.Private.SetKey(ctx, originalConfigurationKey, originalConfiguration)...)
+		saveAppliedConfiguration(ctx, restorer, isRestoreOnDestroy(ctx, req.Plan.GetAttribute, &resp.Diagnostics), innerResp.Private, &resp.Diagnostics)
+	}
+	r.setIdentity(ctx, innerResp.Identity, innerResp.State.Raw, tftypes.Value{}, &resp.Diagnostics)
+	resp.State.Raw = addWrapperAttributes(ctx, resp.State.Schema, innerResp.State.Raw, wrapperAttributesRaw(ctx, resp.State.Schema, req.Plan.Raw, &resp.Diagnostics), &resp.Diagnostics)
+	resp.Identity = innerResp.Identity
+	resp.Private = innerResp.Private
+}
+
+func (r *wrappedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	innerSchema := r.getInnerSchema(ctx, &resp.Diagnostics)
+	timeoutsValue := getTimeouts(ctx, req.State.GetAttribute, &resp.Diagnostics)
+	readTimeout, diags := timeoutsValue.Read(ctx, DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	innerReq := resource.ReadRequest{
+		State:              tfsdk.State{Schema: innerSchema, Raw: stripWrapperAttributes(ctx, innerSchema, req.State.Raw, &resp.Diagnostics)},
+		Identity:           req.Identity,
+		Private:            req.Private,
+		ProviderMeta:       req.ProviderMeta,
+		ClientCapabilities: req.ClientCapabilities,
+	}
+	innerResp := resource.ReadResponse{
+		State:    tfsdk.State{Schema: innerSchema, Raw: stripWrapperAttributes(ctx, innerSchema, resp.State.Raw, &resp.Diagnostics)},
+		Identity: resp.Identity,
+		Private:  resp.Private,
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.inner.Read(ctx, innerReq, &innerResp)
+	resp.Diagnostics.Append(fieldpath.MapDiagnostics(innerSchema, innerResp.Diagnostics)...)
+	r.setIdentity(ctx, innerResp.Identity, innerResp.State.Raw, innerReq.State.Raw, &resp.Diagnostics)
+	resp.State.Raw = addWrapperAttributes(ctx, resp.State.Schema, innerResp.State.Raw, wrapperAttributesRaw(ctx, resp.State.Schema, req.State.Raw, &resp.Diagnostics), &resp.Diagnostics)
+	resp.Identity = innerResp.Identity
+	resp.Private = innerResp.Private
+	resp.Deferred = innerResp.Deferred
+}
+
+func (r *wrappedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.readOnly {
+		addReadOnlyError(&resp.Diagnostics, "update", r.getTypeName(ctx))
+		return
+	}
+	innerSchema := r.getInnerSchema(ctx, &resp.Diagnostics)
+	timeoutsValue := getTimeouts(ctx, req.Plan.GetAttribute, &resp.Diagnostics)
+	updateTimeout, diags := timeoutsValue.Update(ctx, DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	innerConfig := stripWrapperAttributes(ctx, innerSchema, req.Config.Raw, &resp.Diagnostics)
+	innerPlan := withWriteOnlyValues(ctx, innerSchema, stripWrapperAttributes(ctx, innerSchema, req.Plan.Raw, &resp.Diagnostics), innerConfig, &resp.Diagnostics)
+	innerReq := resource.UpdateRequest{
+		Config:       tfsdk.Config{Schema: innerSchema, Raw: innerConfig},
+		Plan:         tfsdk.Plan{Schema: innerSchema, Raw: innerPlan},
+		State:        tfsdk.State{Schema: innerSchema, Raw: stripWrapperAttributes(ctx, innerSchema, req.State.Raw, &resp.Diagnostics)},
+		Identity:     req.Identity,
+		ProviderMeta: req.ProviderMeta,
+		Private:      req.Private,
+	}
+	innerResp := resource.UpdateResponse{
+		State:    tfsdk.State{Schema: innerSchema, Raw: stripWrapperAttributes(ctx, innerSchema, resp.State.Raw, &resp.Diagnostics)},
+		Identity: resp.Identity,
+		Private:  resp.Private,
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.inner.Update(ctx, innerReq, &innerResp)
+	resp.Diagnostics.Append(fieldpath.MapDiagnostics(innerSchema, innerResp.Diagnostics)...)
+	if restorer, ok := r.inner.(ResourceWithRestoreOnDestroy); ok && !resp.Diagnostics.HasError() {
+		saveAppliedConfiguration(ctx, restorer, isRestoreOnDestroy(ctx, req.Plan.GetAttribute, &resp.Diagnostics), innerResp.Private, &resp.Diagnostics)
+	}
+	r.setIdentity(ctx, innerResp.Identity, innerResp.State.Raw, innerReq.State.Raw, &resp.Diagnostics)
+	resp.State.Raw = addWrapperAttributes(ctx, resp.State.Schema, innerResp.State.Raw, wrapperAttributesRaw(ctx, resp.State.Schema, req.Plan.Raw, &resp.Diagnostics), &resp.Diagnostics)
+	resp.Identity = innerResp.Identity
+	resp.Private = innerResp.Private
+}
+
+func (r *wrappedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.readOnly {
+		addReadOnlyError(&resp.Diagnostics, "delete", r.getTypeName(ctx))
+		return
+	}
+	innerSchema := r.getInnerSchema(ctx, &resp.Diagnostics)
+	timeoutsValue := getTimeouts(ctx, req.State.GetAttribute, &resp.Diagnostics)
+	deleteTimeout, diags := timeoutsValue.Delete(ctx, DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Singleton resources with restore_on_destroy set put their original configuration back instead of being deleted
+	if restorer, ok := r.inner.(ResourceWithRestoreOnDestroy); ok && isRestoreOnDestroy(ctx, req.State.GetAttribute, &resp.Diagnostics) {
+		if restoreOriginalConfiguration(ctx, restorer, r.getTypeName(ctx), req.Private, &resp.Diagnostics) {
+			return
+		}
+	}
+
+	innerReq := resource.DeleteRequest{
+		State:        tfsdk.State{Schema: innerSchema, Raw: stripWrapperAttributes(ctx, innerSchema, req.State.Raw, &resp.Diagnostics)},
+		Identity:     req.Identity,
+		ProviderMeta: req.ProviderMeta,
+		Private:      req.Private,
+	}
+	innerResp := resource.DeleteResponse{
+		State:    tfsdk.State{Schema: innerSchema, Raw: stripWrapperAttributes(ctx, innerSchema, resp.State.Raw, &resp.Diagnostics)},
+		Identity: resp.Identity,
+		Private:  resp.Private,
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.inner.Delete(ctx, innerReq, &innerResp)
+	resp.Diagnostics.Append(fieldpath.MapDiagnostics(innerSchema, innerResp.Diagnostics)...)
+	resp.State.Raw = addWrapperAttributes(ctx, resp.State.Schema, innerResp.State.Raw, wrapperAttributesRaw(ctx, resp.State.Schema, req.State.Raw, &resp.Diagnostics), &resp.Diagnostics)
+	resp.Identity = innerResp.Identity
+	resp.Private = innerResp.Private
+}
+
+func (r *wrappedResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	inner, ok := r.inner.(resource.ResourceWithImportState)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Resource Import Not Implemented",
+			"This resource does not support import. Please contact the provider developer for additional information.",
+		)
+		return
+	}
+
+	r.validateImportIdentity(ctx, req.Identity, &resp.Diagnostics)
+	innerSchema := r.getInnerSchema(ctx, &resp.Diagnostics)
+	innerResp := resource.ImportStateResponse{
+		State:    tfsdk.State{Schema: innerSchema, Raw: stripWrapperAttributes(ctx, innerSchema, resp.State.Raw, &resp.Diagnostics)},
+		Identity: resp.Identity,
+		Private:  resp.Private,
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inner.ImportState(ctx, req, &innerResp)
+	resp.Diagnostics.Append(fieldpath.MapDiagnostics(innerSchema, innerResp.Diagnostics)...)
+	r.setIdentity(ctx, innerResp.Identity, innerResp.State.Raw, tftypes.Value{}, &resp.Diagnostics)
+	// Imported resources start with no timeouts configured
+	resp.State.Raw = addWrapperAttributes(ctx, resp.State.Schema, innerResp.State.Raw, wrapperAttributesRaw(ctx, resp.State.Schema, tftypes.Value{}, &resp.Diagnostics), &resp.Diagnostics)
+	resp.Identity = innerResp.Identity
+	resp.Private = innerResp.Private
+	resp.Deferred = innerResp.Deferred
+}
+
+func (r *wrappedResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Plan the identity of resources identified by the server, in case the provider's https_host has changed
+	if r.hasHostIdentity() && r.httpsHost != "" && !req.Plan.Raw.IsNull() {
+		r.setIdentity(ctx, resp.Identity, tftypes.Value{}, tftypes.Value{}, &resp.Diagnostics)
+	}
+
+	innerSchema := r.getInnerSchema(ctx, &resp.Diagnostics)
+	if withRequirements, ok := r.inner.(ResourceWithVersionRequirements); ok && !req.Plan.Raw.IsNull() {
+		checkVersionRequirements(stripWrapperAttributes(ctx, innerSchema, req.Config.Raw, &resp.Diagnostics), r.productVersion, withRequirements.VersionRequirements(), &resp.Diagnostics)
+	}
+
+	inner, ok := r.inner.(resource.ResourceWithModifyPlan)
+	if !ok {
+		return
+	}
+
+	innerReq := resource.ModifyPlanRequest{
+		Config:             tfsdk.Config{Schema: innerSchema, Raw: stripWrapperAttributes(ctx, innerSchema, req.Config.Raw, &resp.Diagnostics)},
+		State:              tfsdk.State{Schema: innerSchema, Raw: stripWrapperAttributes(ctx, innerSchema, req.State.Raw, &resp.Diagnostics)},
+		Plan:               tfsdk.Plan{Schema: innerSchema, Raw: stripWrapperAttributes(ctx, innerSchema, req.Plan.Raw, &resp.Diagnostics)},
+		Identity:           req.Identity,
+		ProviderMeta:       req.ProviderMeta,
+		Private:            req.Private,
+		ClientCapabilities: req.ClientCapabilities,
+	}
+	innerResp := resource.ModifyPlanResponse{
+		Plan:            tfsdk.Plan{Schema: innerSchema, Raw: stripWrapperAttributes(ctx, innerSchema, resp.Plan.Raw, &resp.Diagnostics)},
+		Identity:        resp.Identity,
+		RequiresReplace: resp.RequiresReplace,
+		Private:         resp.Private,
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inner.ModifyPlan(ctx, innerReq, &innerResp)
+	resp.Diagnostics.Append(fieldpath.MapDiagnostics(innerSchema, innerResp.Diagnostics)...)
+	resp.Plan.Raw = addWrapperAttributes(ctx, resp.Plan.Schema, innerResp.Plan.Raw, wrapperAttributesRaw(ctx, resp.Plan.Schema, resp.Plan.Raw, &resp.Diagnostics), &resp.Diagnostics)
+	resp.Identity = innerResp.Identity
+	resp.RequiresReplace = innerResp.RequiresReplace
+	resp.Private = innerResp.Private
+	resp.Deferred = innerResp.Deferred
+}
+
+func (r *wrappedResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	inner, ok := r.inner.(resource.ResourceWithValidateConfig)
+	if !ok {
+		return
+	}
+
+	innerSchema := r.getInnerSchema(ctx, &resp.Diagnostics)
+	innerReq := resource.ValidateConfigRequest{
+		Config:             tfsdk.Config{Schema: innerSchema, Raw: stripWrapperAttributes(ctx, innerSchema, req.Config.Raw, &resp.Diagnostics)},
+		ClientCapabilities: req.ClientCapabilities,
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var innerResp resource.ValidateConfigResponse
+	inner.ValidateConfig(ctx, innerReq, &innerResp)
+	resp.Diagnostics.Append(innerResp.Diagnostics...)
+}
+
+func (r *wrappedResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	if inner, ok := r.inner.(resource.ResourceWithConfigValidators); ok {
+		return inner.ConfigValidators(ctx)
+	}
+	return nil
+}
+
+func (r *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	inner, ok := r.inner.(resource.ResourceWithUpgradeState)
+	if !ok {
+		return nil
+	}
+
+	upgraders := map[int64]resource.StateUpgrader{}
+	for version, upgrader := range inner.UpgradeState(ctx) {
+		upgradeFunc := upgrader.StateUpgrader
+		upgrader.StateUpgrader = func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			innerSchema := r.getInnerSchema(ctx, &resp.Diagnostics)
+			innerResp := resource.UpgradeStateResponse{
+				State: tfsdk.State{Schema: innerSchema, Raw: stripWrapperAttributes(ctx, innerSchema, resp.State.Raw, &resp.Diagnostics)},
+			}
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			upgradeFunc(ctx, req, &innerResp)
+			resp.Diagnostics.Append(innerResp.Diagnostics...)
+			resp.DynamicValue = innerResp.DynamicValue
+			resp.State.Raw = addWrapperAttributes(ctx, resp.State.Schema, innerResp.State.Raw, wrapperAttributesRaw(ctx, resp.State.Schema, tftypes.Value{}, &resp.Diagnostics), &resp.Diagnostics)
+		}
+		upgraders[version] = upgrader
+	}
+	return upgraders
+}
+
+func (r *wrappedResource) MoveState(ctx context.Context) []resource.StateMover {
+	inner, ok := r.inner.(resource.ResourceWithMoveState)
+	if !ok {
+		return nil
+	}
+
+	var movers []resource.StateMover
+	for _, mover := range inner.MoveState(ctx) {
+		moveFunc := mover.StateMover
+		mover.StateMover = func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			innerSchema := r.getInnerSchema(ctx, &resp.Diagnostics)
+			innerResp := resource.MoveStateResponse{
+				TargetState:    tfsdk.State{Schema: innerSchema, Raw: stripWrapperAttributes(ctx, innerSchema, resp.TargetState.Raw, &resp.Diagnostics)},
+				TargetIdentity: resp.TargetIdentity,
+				TargetPrivate:  resp.TargetPrivate,
+			}
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			moveFunc(ctx, req, &innerResp)
+			resp.Diagnostics.Append(innerResp.Diagnostics...)
+			resp.TargetState.Raw = addWrapperAttributes(ctx, resp.TargetState.Schema, innerResp.TargetState.Raw, wrapperAttributesRaw(ctx, resp.TargetState.Schema, tftypes.Value{}, &resp.Diagnostics), &resp.Diagnostics)
+			resp.TargetIdentity = innerResp.TargetIdentity
+			resp.TargetPrivate = innerResp.TargetPrivate
+			if !innerResp.TargetState.Raw.IsNull() {
+				r.setIdentity(ctx, resp.TargetIdentity, innerResp.TargetState.Raw, tftypes.Value{}, &resp.Diagnostics)
+			}
+		}
+		movers = append(movers, mover)
+	}
+	return movers
+}
@@ -0,0 +1,52 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package resourcewrapper
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+)
+
+// Copy the value of each write-only attribute from the config into the plan. Write-only attributes are always
+// planned as null, so this allows wrapped resources to read write-only values from the plan like any other attribute
+// when building requests. The framework removes write-only values from the resulting state.
+func withWriteOnlyValues(ctx context.Context, innerSchema schema.Schema, plan, config tftypes.Value, diags *diag.Diagnostics) tftypes.Value {
+	if plan.IsNull() || !plan.IsKnown() || config.IsNull() || !config.IsKnown() {
+		return plan
+	}
+
+	result, err := tftypes.Transform(plan, func(p *tftypes.AttributePath, value tftypes.Value) (tftypes.Value, error) {
+		if len(p.Steps()) == 0 {
+			return value, nil
+		}
+		// Paths to blocks and collection elements are not attributes, and are left as is
+		attribute, err := innerSchema.AttributeAtTerraformPath(ctx, p)
+		if err != nil || !attribute.IsWriteOnly() {
+			return value, nil
+		}
+		configValue, _, err := tftypes.WalkAttributePath(config, p)
+		if err != nil {
+			// Nothing is set within a null or unknown value in the config
+			if parent, ok := configValue.(tftypes.Value); ok && (parent.IsNull() || !parent.IsKnown()) {
+				return value, nil
+			}
+			// Set elements are identified by their values, which differ between the plan and the config in the
+			// write-only attributes themselves, so write-only attributes within sets can't be found in the config
+			attrPath, _ := toAttributePath(p)
+			diags.AddAttributeError(attrPath, providererror.InternalProviderError,
+				"Failed to read the write-only value of this attribute from the resource config, so it can't be sent to PingFederate. "+
+					"Write-only attributes within sets are not supported: "+err.Error())
+			return value, nil
+		}
+		return configValue.(tftypes.Value), nil
+	})
+	if err != nil {
+		diags.AddError(providererror.InternalProviderError, "Failed to read write-only values from resource config: "+err.Error())
+		return plan
+	}
+	return result
+}
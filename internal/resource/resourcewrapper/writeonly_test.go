@@ -0,0 +1,84 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package resourcewrapper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestWithWriteOnlyValues(t *testing.T) {
+	ctx := context.Background()
+	nestedObject := schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"name":      schema.StringAttribute{Required: true},
+			"secret_wo": schema.StringAttribute{Optional: true, WriteOnly: true},
+		},
+	}
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"secret_wo": schema.StringAttribute{Optional: true, WriteOnly: true},
+			"list":      schema.ListNestedAttribute{Optional: true, NestedObject: nestedObject},
+			"set":       schema.SetNestedAttribute{Optional: true, NestedObject: nestedObject},
+			"single":    schema.SingleNestedAttribute{Optional: true, Attributes: nestedObject.Attributes},
+		},
+	}
+	objectType := testSchema.Type().TerraformType(ctx).(tftypes.Object)
+	elementType := objectType.AttributeTypes["list"].(tftypes.List).ElementType
+	element := func(name string, secret interface{}) tftypes.Value {
+		return tftypes.NewValue(elementType, map[string]tftypes.Value{
+			"name":      tftypes.NewValue(tftypes.String, name),
+			"secret_wo": tftypes.NewValue(tftypes.String, secret),
+		})
+	}
+	value := func(secret interface{}, list, set []tftypes.Value, single tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"secret_wo": tftypes.NewValue(tftypes.String, secret),
+			"list":      tftypes.NewValue(objectType.AttributeTypes["list"], list),
+			"set":       tftypes.NewValue(objectType.AttributeTypes["set"], set),
+			"single":    single,
+		})
+	}
+	nullSingle := tftypes.NewValue(elementType, nil)
+
+	t.Run("top-level and list attributes", func(t *testing.T) {
+		plan := value(nil, []tftypes.Value{element("a", nil)}, nil, nullSingle)
+		config := value("top", []tftypes.Value{element("a", "nested")}, nil, nullSingle)
+		var diags diag.Diagnostics
+		result := withWriteOnlyValues(ctx, testSchema, plan, config, &diags)
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		if !result.Equal(config) {
+			t.Errorf("expected %s, got %s", config, result)
+		}
+	})
+
+	t.Run("null parent in config", func(t *testing.T) {
+		// A nested object planned from defaults may be null in the config, in which case nothing is written
+		plan := value(nil, nil, nil, element("default", nil))
+		config := value(nil, nil, nil, nullSingle)
+		var diags diag.Diagnostics
+		result := withWriteOnlyValues(ctx, testSchema, plan, config, &diags)
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		if !result.Equal(plan) {
+			t.Errorf("expected %s, got %s", plan, result)
+		}
+	})
+
+	t.Run("set attributes", func(t *testing.T) {
+		plan := value(nil, nil, []tftypes.Value{element("a", nil)}, nullSingle)
+		config := value(nil, nil, []tftypes.Value{element("a", "nested")}, nullSingle)
+		var diags diag.Diagnostics
+		withWriteOnlyValues(ctx, testSchema, plan, config, &diags)
+		if !diags.HasError() {
+			t.Errorf("expected an error for a write-only attribute within a set")
+		}
+	})
+}
@@ -5,22 +5,36 @@ package types
 import (
 	"net/http"
 
+	"golang.org/x/oauth2"
+
 	client "github.com/pingidentity/pingfederate-go-client/v1300/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/plugindescriptor"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/policycontract"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/version"
 )
 
 // Configuration used by the provider and resources
 type ProviderConfiguration struct {
-	HttpsHost      string
-	Transport      *http.Transport
-	Username       *string
-	Password       *string
-	AccessToken    *string
-	TokenUrl       *string
-	ClientId       *string
-	ClientSecret   *string
-	Scopes         []string
-	ProductVersion version.SupportedVersion
+	HttpsHost    string
+	Transport    *http.Transport
+	Username     *string
+	Password     *string
+	AccessToken  *string
+	TokenUrl     *string
+	ClientId     *string
+	ClientSecret *string
+	Scopes       []string
+	// Shared token source that caches OAuth access tokens across all resources
+	OAuthTokenSource oauth2.TokenSource
+	// Shared cache of plugin descriptors retrieved by all resources
+	PluginDescriptors *plugindescriptor.Cache
+	// Shared cache of authentication policy contracts retrieved by all resources
+	AuthenticationPolicyContracts *policycontract.Cache
+	ProductVersion                version.SupportedVersion
+	// When true, the provider must not make any changes to the PingFederate server
+	ReadOnly bool
+	// When true, creates that fail because the object already exists update the existing object instead
+	AdoptExistingOnCreate bool
 }
 
 // Configuration passed to resources
@@ -0,0 +1,55 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package version
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+)
+
+// AttributeRequirement declares the range of PingFederate versions that support an attribute of a resource
+type AttributeRequirement struct {
+	// Path of the attribute in the resource schema, with the names of nested attributes separated by dots,
+	// e.g. "idp_browser_sso.passthrough_errors". List, set, and map elements are not part of the path.
+	Path string
+	// The first PingFederate version that supports the attribute. Leave empty if supported by all earlier versions.
+	Minimum SupportedVersion
+	// The last PingFederate version that supports the attribute. Leave empty if supported by all later versions.
+	Maximum SupportedVersion
+}
+
+// Check adds an error to diags if the attribute at attrPath is not supported by actualVersion
+func (r AttributeRequirement) Check(attrPath path.Path, actualVersion SupportedVersion, diags *diag.Diagnostics) {
+	if r.Minimum != "" {
+		compare, err := Compare(actualVersion, r.Minimum)
+		if err != nil {
+			diags.AddError(providererror.InternalProviderError, "Failed to compare PingFederate versions: "+err.Error())
+			return
+		}
+		if compare < 0 {
+			diags.AddAttributeError(
+				attrPath,
+				providererror.InvalidProductVersionAttribute,
+				fmt.Sprintf("PingFederate version %s or later is required for attribute %s. "+
+					"PingFederate version %s was provided via the 'product_version' field in your provider configuration or the 'PINGFEDERATE_PROVIDER_PRODUCT_VERSION' environment variable, or detected from the PingFederate server.", string(r.Minimum), r.Path, string(actualVersion)))
+			return
+		}
+	}
+	if r.Maximum != "" {
+		compare, err := Compare(actualVersion, r.Maximum)
+		if err != nil {
+			diags.AddError(providererror.InternalProviderError, "Failed to compare PingFederate versions: "+err.Error())
+			return
+		}
+		if compare > 0 {
+			diags.AddAttributeError(
+				attrPath,
+				providererror.InvalidProductVersionAttribute,
+				fmt.Sprintf("Attribute %s is not supported after PingFederate version %s. "+
+					"PingFederate version %s was provided via the 'product_version' field in your provider configuration or the 'PINGFEDERATE_PROVIDER_PRODUCT_VERSION' environment variable, or detected from the PingFederate server.", r.Path, string(r.Maximum), string(actualVersion)))
+		}
+	}
+}
@@ -5,6 +5,7 @@ package version
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -134,6 +135,42 @@ func getLatestPatchForMajorMinorVersion(majorMinorVersionString string) (string,
 	return string(sortedVersions[versionIndex]), respDiags
 }
 
+// Check if a version, given as its major, minor, and optional patch digits, is newer than the latest supported version
+func isNewerThanLatest(versionDigits []string) bool {
+	sortedVersions := getSortedVersions()
+	latestDigits := strings.Split(string(sortedVersions[len(sortedVersions)-1]), ".")
+	for i, digit := range versionDigits {
+		if i >= len(latestDigits) {
+			return false
+		}
+		value, err := strconv.Atoi(digit)
+		if err != nil {
+			return false
+		}
+		latestValue, _ := strconv.Atoi(latestDigits[i])
+		if value != latestValue {
+			return value > latestValue
+		}
+	}
+	return false
+}
+
+// Get the latest supported version, with a warning that the given version is newer than any version this version of
+// the provider recognizes. Newer versions are assumed to behave like the latest supported version, so that new
+// PingFederate releases can be used before the provider is updated.
+func getLatestVersionForNewerVersion(versionString, source string) (SupportedVersion, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	sortedVersions := getSortedVersions()
+	latestVersion := sortedVersions[len(sortedVersions)-1]
+	diags.AddAttributeWarning(
+		path.Root("product_version"),
+		"Unrecognized PingFederate version "+source,
+		"PingFederate version '"+versionString+"' is newer than the versions recognized by this version of the PingFederate terraform provider. "+
+			"Assuming the behavior of the latest version supported by the provider: '"+string(latestVersion)+"'. "+
+			"Attributes added in later PingFederate versions can't be configured until the provider is upgraded.")
+	return latestVersion, diags
+}
+
 func Parse(versionString string) (SupportedVersion, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	if len(versionString) == 0 {
@@ -154,6 +191,10 @@ func Parse(versionString string) (SupportedVersion, diag.Diagnostics) {
 	}
 	if len(versionDigits) == 2 {
 		if !IsValid(versionString + ".0") {
+			// Newer major minor versions are assumed to behave like the latest supported version
+			if isNewerThanLatest(versionDigits) {
+				return getLatestVersionForNewerVersion(versionString, "in 'product_version' field or 'PINGFEDERATE_PROVIDER_PRODUCT_VERSION' environment variable")
+			}
 			// This major minor version isn't supported - fail now
 			diags.AddAttributeError(
 				path.Root("product_version"),
@@ -170,6 +211,9 @@ func Parse(versionString string) (SupportedVersion, diag.Diagnostics) {
 		// Check if the major-minor version is valid
 		majorMinorVersionString := versionDigits[0] + "." + versionDigits[1] + ".0"
 		if !IsValid(majorMinorVersionString) {
+			if isNewerThanLatest(versionDigits) {
+				return getLatestVersionForNewerVersion(versionString, "in 'product_version' field or 'PINGFEDERATE_PROVIDER_PRODUCT_VERSION' environment variable")
+			}
 			diags.AddAttributeError(
 				path.Root("product_version"),
 				providererror.InvalidProviderConfiguration,
@@ -189,16 +233,54 @@ func Parse(versionString string) (SupportedVersion, diag.Diagnostics) {
 	return SupportedVersion(versionString), diags
 }
 
-func AddUnsupportedAttributeError(attr string, actualVersion, requiredVersion SupportedVersion, diags *diag.Diagnostics) {
-	if diags == nil {
-		return
+// ParseServerVersion parses a version string reported by the PingFederate server's version endpoint.
+// The server may include a build number (e.g. "12.2.0.4"), which is ignored. Unrecognized patch versions
+// of supported major-minor versions are mapped onto the latest known patch, and versions newer than any supported
+// version are mapped onto the latest supported version, with a warning.
+func ParseServerVersion(serverVersion string) (SupportedVersion, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	versionDigits := strings.Split(strings.TrimSpace(serverVersion), ".")
+	if len(versionDigits) < 3 {
+		diags.AddAttributeError(
+			path.Root("product_version"),
+			providererror.InvalidProviderConfiguration,
+			"failed to parse PingFederate version '"+serverVersion+"' reported by the PingFederate server. Expected at least three digits (e.g. '12.2.0')")
+		return "", diags
+	}
+
+	versionString := strings.Join(versionDigits[:3], ".")
+	if IsValid(versionString) {
+		return SupportedVersion(versionString), diags
+	}
+
+	majorMinorVersionString := versionDigits[0] + "." + versionDigits[1] + ".0"
+	if !IsValid(majorMinorVersionString) {
+		if isNewerThanLatest(versionDigits[:3]) {
+			return getLatestVersionForNewerVersion(versionString, "reported by the PingFederate server")
+		}
+		diags.AddAttributeError(
+			path.Root("product_version"),
+			providererror.InvalidProviderConfiguration,
+			"PingFederate version '"+versionString+"' reported by the PingFederate server is not supported in this version of the PingFederate terraform provider.\n"+getSortedVersionsMessage())
+		return "", diags
 	}
 
-	diags.AddAttributeError(
-		path.Root(attr),
-		providererror.InvalidProductVersionAttribute,
-		fmt.Sprintf("PingFederate version %s or later is required for attribute %s. "+
-			"PingFederate version %s was provided via the 'product_version' field in your provider configuration or the 'PINGFEDERATE_PROVIDER_PRODUCT_VERSION' environment variable.", string(requiredVersion), attr, string(actualVersion)))
+	latestPatchVersionString, respDiags := getLatestPatchForMajorMinorVersion(majorMinorVersionString)
+	diags.Append(respDiags...)
+	diags.AddAttributeWarning(
+		path.Root("product_version"),
+		"Unrecognized PingFederate patch version reported by the PingFederate server",
+		"PingFederate patch version '"+versionString+"' is not recognized by this version of the PingFederate terraform provider. Assuming the latest patch version supported by the provider: '"+latestPatchVersionString+"'")
+	return SupportedVersion(latestPatchVersionString), diags
+}
+
+// MajorMinor returns the major-minor portion of a supported version, e.g. "12.2" for "12.2.3"
+func MajorMinor(v SupportedVersion) string {
+	versionDigits := strings.Split(string(v), ".")
+	if len(versionDigits) < 2 {
+		return string(v)
+	}
+	return versionDigits[0] + "." + versionDigits[1]
 }
 
 func AddUnsupportedResourceError(resource string, actualVersion, requiredVersion SupportedVersion, diags *diag.Diagnostics) {
@@ -0,0 +1,178 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package version
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		versionString    string
+		expected         SupportedVersion
+		expectedErrors   int
+		expectedWarnings int
+	}{
+		{versionString: "13.0.2", expected: PingFederate1302},
+		{versionString: "13.0", expected: PingFederate1304},
+		{versionString: "13.1", expected: PingFederate1311},
+		// Unrecognized patch of a supported major-minor version
+		{versionString: "13.0.9", expected: PingFederate1304, expectedWarnings: 1},
+		// Newer patch, minor, and major versions than the latest supported version
+		{versionString: "13.1.9", expected: PingFederate1311, expectedWarnings: 1},
+		{versionString: "13.2", expected: PingFederate1311, expectedWarnings: 1},
+		{versionString: "13.2.0", expected: PingFederate1311, expectedWarnings: 1},
+		{versionString: "14.0.0", expected: PingFederate1311, expectedWarnings: 1},
+		// Older and invalid versions
+		{versionString: "11.3", expectedErrors: 1},
+		{versionString: "11.3.4", expectedErrors: 1},
+		{versionString: "", expectedErrors: 1},
+		{versionString: "13", expectedErrors: 1},
+		{versionString: "13.1.1.4", expectedErrors: 1},
+		{versionString: "abc.def", expectedErrors: 1},
+		{versionString: "13.x.0", expectedErrors: 1},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.versionString, func(t *testing.T) {
+			result, diags := Parse(testCase.versionString)
+			checkParseResult(t, result, diags, testCase.expected, testCase.expectedErrors, testCase.expectedWarnings)
+		})
+	}
+}
+
+func TestParseServerVersion(t *testing.T) {
+	testCases := []struct {
+		serverVersion    string
+		expected         SupportedVersion
+		expectedErrors   int
+		expectedWarnings int
+	}{
+		{serverVersion: "13.0.2", expected: PingFederate1302},
+		// Build numbers are ignored
+		{serverVersion: "13.1.1.4", expected: PingFederate1311},
+		{serverVersion: " 12.2.0.1 ", expected: PingFederate1220},
+		// Unrecognized patch of a supported major-minor version
+		{serverVersion: "12.3.9.0", expected: PingFederate1236, expectedWarnings: 1},
+		// Newer patch, minor, and major versions than the latest supported version
+		{serverVersion: "13.1.2.0", expected: PingFederate1311, expectedWarnings: 1},
+		{serverVersion: "13.2.0.0", expected: PingFederate1311, expectedWarnings: 1},
+		{serverVersion: "14.0.0", expected: PingFederate1311, expectedWarnings: 1},
+		// Older and invalid versions
+		{serverVersion: "11.3.0.2", expectedErrors: 1},
+		{serverVersion: "", expectedErrors: 1},
+		{serverVersion: "13.1", expectedErrors: 1},
+		{serverVersion: "not a version", expectedErrors: 1},
+		{serverVersion: "13.x.0", expectedErrors: 1},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.serverVersion, func(t *testing.T) {
+			result, diags := ParseServerVersion(testCase.serverVersion)
+			checkParseResult(t, result, diags, testCase.expected, testCase.expectedErrors, testCase.expectedWarnings)
+		})
+	}
+}
+
+func checkParseResult(t *testing.T, result SupportedVersion, diags diag.Diagnostics, expected SupportedVersion, expectedErrors, expectedWarnings int) {
+	t.Helper()
+	if diags.ErrorsCount() != expectedErrors || diags.WarningsCount() != expectedWarnings {
+		t.Fatalf("expected %d errors and %d warnings, got %v", expectedErrors, expectedWarnings, diags)
+	}
+	if result != expected {
+		t.Errorf("expected version '%s', got '%s'", expected, result)
+	}
+}
+
+func TestIsNewerThanLatest(t *testing.T) {
+	testCases := []struct {
+		versionDigits []string
+		expected      bool
+	}{
+		{versionDigits: []string{"13", "1", "1"}},
+		{versionDigits: []string{"13", "1", "2"}, expected: true},
+		{versionDigits: []string{"13", "2"}, expected: true},
+		{versionDigits: []string{"14", "0", "0"}, expected: true},
+		{versionDigits: []string{"13", "0", "9"}},
+		{versionDigits: []string{"12", "9"}},
+		{versionDigits: []string{"13", "x", "0"}},
+		{versionDigits: []string{"13", "1", "1", "4"}},
+	}
+
+	for _, testCase := range testCases {
+		if result := isNewerThanLatest(testCase.versionDigits); result != testCase.expected {
+			t.Errorf("expected %t for %v, got %t", testCase.expected, testCase.versionDigits, result)
+		}
+	}
+}
+
+func TestAttributeRequirementCheck(t *testing.T) {
+	testCases := []struct {
+		name           string
+		requirement    AttributeRequirement
+		actualVersion  SupportedVersion
+		expectedErrors int
+	}{
+		{
+			name:          "no bounds",
+			requirement:   AttributeRequirement{Path: "attr"},
+			actualVersion: PingFederate1220,
+		},
+		{
+			name:           "below minimum",
+			requirement:    AttributeRequirement{Path: "attr", Minimum: PingFederate1300},
+			actualVersion:  PingFederate1236,
+			expectedErrors: 1,
+		},
+		{
+			name:          "equal to minimum",
+			requirement:   AttributeRequirement{Path: "attr", Minimum: PingFederate1300},
+			actualVersion: PingFederate1300,
+		},
+		{
+			name:          "above minimum",
+			requirement:   AttributeRequirement{Path: "attr", Minimum: PingFederate1300},
+			actualVersion: PingFederate1311,
+		},
+		{
+			name:          "below maximum",
+			requirement:   AttributeRequirement{Path: "attr", Maximum: PingFederate1236},
+			actualVersion: PingFederate1220,
+		},
+		{
+			name:          "equal to maximum",
+			requirement:   AttributeRequirement{Path: "attr", Maximum: PingFederate1236},
+			actualVersion: PingFederate1236,
+		},
+		{
+			name:           "above maximum",
+			requirement:    AttributeRequirement{Path: "attr", Maximum: PingFederate1236},
+			actualVersion:  PingFederate1300,
+			expectedErrors: 1,
+		},
+		{
+			name:          "equal to minimum and maximum",
+			requirement:   AttributeRequirement{Path: "attr", Minimum: PingFederate1300, Maximum: PingFederate1300},
+			actualVersion: PingFederate1300,
+		},
+		{
+			name:           "invalid version",
+			requirement:    AttributeRequirement{Path: "attr", Minimum: PingFederate1300},
+			actualVersion:  "13.9.9",
+			expectedErrors: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			testCase.requirement.Check(path.Root("attr"), testCase.actualVersion, &diags)
+			if diags.ErrorsCount() != testCase.expectedErrors {
+				t.Errorf("expected %d errors, got %v", testCase.expectedErrors, diags)
+			}
+		})
+	}
+}